@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/requestctx"
+	trxHandler "github.com/aswinudhayakumar/account-transactions/pkg/handler/transactions"
+	"github.com/aswinudhayakumar/account-transactions/pkg/importer"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// importCommand is the subcommand importing a transactions file instead of serving HTTP.
+const importCommand = "import"
+
+// runImport runs the import subcommand with its command line arguments, e.g.
+// account-transactions import --file transactions.csv
+func runImport(ctx context.Context, conf env, db *sqlx.DB, args []string) error {
+	flags := flag.NewFlagSet(importCommand, flag.ContinueOnError)
+	file := flags.String("file", "", "path of the CSV or NDJSON transactions file to import")
+	format := flags.String("format", "", "format of the file, csv or ndjson, detected from the file extension by default")
+	batchSize := flags.Int("batch-size", conf.ImportBatchSize, "number of rows loaded per database transaction")
+	errorsFile := flags.String("errors-file", "", "path of the NDJSON file the rejected rows are written to, <file>.errors.ndjson by default")
+	actor := flags.String("actor", "import", "actor recorded in the audit log of the imported transactions")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *file == "" {
+		return errors.New("the --file flag is required")
+	}
+	if *format == "" {
+		*format = strings.TrimPrefix(strings.ToLower(filepath.Ext(*file)), ".")
+	}
+	if *errorsFile == "" {
+		*errorsFile = *file + ".errors.ndjson"
+	}
+
+	in, err := os.Open(*file)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	rows, err := importer.NewRowReader(in, *format)
+	if err != nil {
+		return err
+	}
+
+	rejects, err := os.Create(*errorsFile)
+	if err != nil {
+		return err
+	}
+	defer rejects.Close()
+
+	im := importer.Importer{
+		DataRepo: repository.NewDataRepo(db),
+		Config: trxHandler.Config{
+			EventDateBackdateWindow: conf.EventDateBackdateWindow,
+			EventDateFutureSkew:     conf.EventDateFutureSkew,
+		},
+		BatchSize: *batchSize,
+		Rejects:   rejects,
+	}
+
+	// All the changes of an import share a request ID in the audit log
+	importID, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+	ctx = requestctx.WithActor(ctx, *actor)
+	ctx = requestctx.WithRequestID(ctx, importID.String())
+	summary, err := im.Import(ctx, rows)
+
+	// The summary is printed even when the import stops half way
+	fmt.Printf("📄 Imported %s as request %s: %s\n", *file, importID, summary)
+	if summary.Rejected > 0 {
+		fmt.Printf("📄 Rejected rows written to %s\n", *errorsFile)
+	}
+	return err
+}
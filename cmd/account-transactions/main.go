@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/aswinudhayakumar/account-transactions/internal/logger"
@@ -23,6 +24,18 @@ type env struct {
 	SSLMode    string `envconfig:"SSL_MODE"`
 
 	ShutdownTimeout time.Duration `envconfig:"SHUTDOWN_TIMEOUT" default:"5s"`
+
+	// Accepted range of client supplied transaction event dates
+	EventDateBackdateWindow time.Duration `envconfig:"EVENT_DATE_BACKDATE_WINDOW" default:"720h"`
+	EventDateFutureSkew     time.Duration `envconfig:"EVENT_DATE_FUTURE_SKEW" default:"5m"`
+
+	// Number of rows loaded per database transaction by the import subcommand
+	ImportBatchSize int `envconfig:"IMPORT_BATCH_SIZE" default:"500"`
+
+	// Lifetime of holds and how often the expired ones are released
+	HoldTTL             time.Duration `envconfig:"HOLD_TTL" default:"168h"`
+	HoldExpiryInterval  time.Duration `envconfig:"HOLD_EXPIRY_INTERVAL" default:"1m"`
+	HoldExpiryBatchSize int           `envconfig:"HOLD_EXPIRY_BATCH_SIZE" default:"500"`
 }
 
 func main() {
@@ -49,6 +62,12 @@ func main() {
 	err = migrator.RunMigrations(db.DB)
 	failOnError(err, "🛑 failed to apply db migrations")
 
+	// Import a transactions file instead of serving HTTP
+	if len(os.Args) > 1 && os.Args[1] == importCommand {
+		failOnError(runImport(ctx, conf, db, os.Args[2:]), "🛑 failed to import transactions")
+		return
+	}
+
 	// Initialise the HTTP web server
 	webServerConfig := buildWebServerConfig(conf, db)
 	webServer := webServerConfig.InitWebServer()
@@ -61,6 +80,14 @@ func main() {
 		}
 	}()
 
+	// Run the hold expiry worker until shutdown, it's stopped before the DB connection is closed
+	expirerCtx, stopExpirer := context.WithCancel(ctx)
+	expirerDone := make(chan struct{})
+	go func() {
+		defer close(expirerDone)
+		failOnError(webServerConfig.holdExpirer.Run(expirerCtx), "🛑 failed to start hold expiry worker")
+	}()
+
 	// Graceful shutdown
 	signal.Add(func() {
 		_, shutdownCancel := context.WithTimeout(context.Background(), conf.ShutdownTimeout)
@@ -69,6 +96,10 @@ func main() {
 		if err := webServer.Shutdown(ctx); err != nil {
 			log.Printf("🛑 Failed to shut down HTTP server: %v", err)
 		}
+
+		// Wait for the running expiry batch to roll back
+		stopExpirer()
+		<-expirerDone
 		db.Close()
 	})
 
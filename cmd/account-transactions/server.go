@@ -4,7 +4,10 @@ import (
 	"net/http"
 
 	"github.com/aswinudhayakumar/account-transactions/internal/middleware"
+	"github.com/aswinudhayakumar/account-transactions/pkg/expirer"
 	accHandler "github.com/aswinudhayakumar/account-transactions/pkg/handler/accounts"
+	auditHandler "github.com/aswinudhayakumar/account-transactions/pkg/handler/audit"
+	opTypeHandler "github.com/aswinudhayakumar/account-transactions/pkg/handler/operationtypes"
 	trxHandler "github.com/aswinudhayakumar/account-transactions/pkg/handler/transactions"
 	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
 	"github.com/go-chi/chi/v5"
@@ -16,6 +19,9 @@ type WebServerConfig struct {
 	conf            env
 	accountsHandler accHandler.AccountsHandler
 	trxHandler      trxHandler.TransactionsHandler
+	opTypeHandler   opTypeHandler.OperationTypesHandler
+	auditHandler    auditHandler.AuditHandler
+	holdExpirer     *expirer.HoldExpirer
 }
 
 // buildWebServerConfig builds and returns a new WebServerConfig
@@ -23,10 +29,23 @@ func buildWebServerConfig(conf env, db *sqlx.DB) WebServerConfig {
 	// Initialise data repository
 	dataRepo := repository.NewDataRepo(db)
 
+	trxConfig := trxHandler.Config{
+		EventDateBackdateWindow: conf.EventDateBackdateWindow,
+		EventDateFutureSkew:     conf.EventDateFutureSkew,
+		HoldTTL:                 conf.HoldTTL,
+	}
+
 	return WebServerConfig{
 		conf:            conf,
 		accountsHandler: accHandler.NewAccountsHandler(dataRepo),
-		trxHandler:      trxHandler.NewTransactionsHandler(dataRepo),
+		trxHandler:      trxHandler.NewTransactionsHandler(dataRepo, trxConfig),
+		opTypeHandler:   opTypeHandler.NewOperationTypesHandler(dataRepo),
+		auditHandler:    auditHandler.NewAuditHandler(dataRepo),
+		holdExpirer: &expirer.HoldExpirer{
+			DataRepo:  dataRepo,
+			Interval:  conf.HoldExpiryInterval,
+			BatchSize: conf.HoldExpiryBatchSize,
+		},
 	}
 }
 
@@ -34,17 +53,50 @@ func buildWebServerConfig(conf env, db *sqlx.DB) WebServerConfig {
 func (ws *WebServerConfig) InitWebServer() *http.Server {
 	r := chi.NewRouter()
 	r.Use(middleware.RecoverInterceptor)
+	r.Use(middleware.RequestContext)
 
 	r.Route("/app/v1", func(r chi.Router) {
 		// accounts API handlers
 		r.Route("/accounts", func(r chi.Router) {
 			r.Post("/", ws.accountsHandler.CreateAccount)
+			r.Get("/", ws.accountsHandler.GetAccountByDocumentNumber)
 			r.Get("/{id}", ws.accountsHandler.GetAccountByAccountID)
+			r.Patch("/{id}", ws.accountsHandler.UpdateAccount)
+			r.Patch("/{id}/status", ws.accountsHandler.UpdateAccountStatus)
+			r.Get("/{id}/balance", ws.accountsHandler.GetAccountBalance)
+			r.Get("/{id}/transactions", ws.trxHandler.ListAccountTransactions)
+			r.Get("/{id}/statement", ws.trxHandler.GetAccountStatement)
 		})
 
 		// transactions API handlers
-		r.Post("/transactions", ws.trxHandler.CreateTransaction)
+		r.Post("/transactions:batch", ws.trxHandler.CreateTransactionsBatch)
+		r.Route("/transactions", func(r chi.Router) {
+			r.Post("/", ws.trxHandler.CreateTransaction)
+			r.Get("/{id}", ws.trxHandler.GetTransactionByID)
+			r.Get("/{id}/installments", ws.trxHandler.ListTransactionInstallments)
+			r.Post("/{id}/reversal", ws.trxHandler.ReverseTransaction)
+		})
+
+		// transfers API handlers
+		r.Post("/transfers", ws.trxHandler.CreateTransfer)
+
+		// holds API handlers
+		r.Route("/holds", func(r chi.Router) {
+			r.Post("/", ws.trxHandler.CreateHold)
+			r.Post("/{id}/capture", ws.trxHandler.CaptureHold)
+			r.Post("/{id}/void", ws.trxHandler.VoidHold)
+		})
+
+		// operation types API handlers
+		r.Route("/operation-types", func(r chi.Router) {
+			r.Get("/", ws.opTypeHandler.ListOperationTypes)
+			r.Post("/", ws.opTypeHandler.CreateOperationType)
+			r.Get("/{id}", ws.opTypeHandler.GetOperationTypeByID)
+			r.Patch("/{id}", ws.opTypeHandler.UpdateOperationType)
+		})
 
+		// audit log API handlers
+		r.Get("/audit", ws.auditHandler.ListAuditEntries)
 	})
 
 	return &http.Server{
@@ -0,0 +1,82 @@
+package decoder
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+)
+
+// MaxBodyBytes is the maximum size of a JSON request body.
+const MaxBodyBytes = 1 << 20
+
+// Errors
+var (
+	ErrUnsupportedMediaType = errors.New("content-type must be application/json")
+	ErrRequestTooLarge      = fmt.Errorf("request body must not be larger than %d bytes", MaxBodyBytes)
+	ErrMalformedRequest     = errors.New("request body must be a single JSON object")
+)
+
+// DecodeJSON decodes the JSON request body into v. The request must have a JSON Content-Type and
+// the body must hold a single JSON value of at most MaxBodyBytes, without any unknown fields.
+func DecodeJSON(w http.ResponseWriter, r *http.Request, v interface{}) error {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "application/json" {
+		return ErrUnsupportedMediaType
+	}
+
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, MaxBodyBytes))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(v); err != nil {
+		return decodeError(err)
+	}
+
+	// Anything after the JSON value makes the request malformed
+	if err := decoder.Decode(&struct{}{}); err != io.EOF {
+		if err == nil {
+			return ErrMalformedRequest
+		}
+		return decodeError(err)
+	}
+
+	return nil
+}
+
+// ErrorResponse returns the status code and error description to respond with for a DecodeJSON error.
+func ErrorResponse(err error) (int, writer.ErrorDescription) {
+	switch {
+	case errors.Is(err, ErrUnsupportedMediaType):
+		return http.StatusUnsupportedMediaType, writer.ErrorDescription{
+			Title:  writer.ErrTitleUnsupportedMediaType,
+			Code:   writer.ErrCodeUnsupportedMediaType,
+			Detail: err.Error(),
+		}
+	case errors.Is(err, ErrRequestTooLarge):
+		return http.StatusRequestEntityTooLarge, writer.ErrorDescription{
+			Title:  writer.ErrTitleRequestTooLarge,
+			Code:   writer.ErrCodeRequestTooLarge,
+			Detail: err.Error(),
+		}
+	default:
+		return http.StatusBadRequest, writer.ErrorDescription{
+			Title:  writer.ErrTitleInvalidRequestPayload,
+			Code:   writer.ErrCodeInvalidRequest,
+			Detail: "The request payload is malformed or invalid.",
+		}
+	}
+}
+
+// decodeError wraps the error of the JSON decoder with the matching decoding error.
+func decodeError(err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return ErrRequestTooLarge
+	}
+
+	return fmt.Errorf("%w: %s", ErrMalformedRequest, err.Error())
+}
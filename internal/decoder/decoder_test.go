@@ -0,0 +1,84 @@
+package decoder
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// testDecoderSuite is a test suite object to test the JSON request decoding.
+type testDecoderSuite struct {
+	suite.Suite
+}
+
+// TestDecoderSuite is the custom test suite runner for the JSON request decoding.
+func TestDecoderSuite(t *testing.T) {
+	suite.Run(t, new(testDecoderSuite))
+}
+
+// decodeReq is the request object decoded in the tests.
+type decodeReq struct {
+	Name string `json:"name"`
+}
+
+// decode decodes the body with the given Content-Type and returns the decoded request and the status code
+// of the error response, which is zero when the body decodes.
+func (s *testDecoderSuite) decode(contentType, body string) (decodeReq, int, error) {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	if contentType != "" {
+		r.Header.Set("Content-Type", contentType)
+	}
+
+	var req decodeReq
+	err := DecodeJSON(httptest.NewRecorder(), r, &req)
+	if err != nil {
+		statusCode, _ := ErrorResponse(err)
+		return req, statusCode, err
+	}
+
+	return req, 0, nil
+}
+
+// @Success testcase
+func (s *testDecoderSuite) TestDecodeJSON() {
+	testcases := map[string]string{
+		"json":              "application/json",
+		"json with charset": "application/json; charset=utf-8",
+	}
+
+	for name, contentType := range testcases {
+		req, statusCode, err := s.decode(contentType, `{"name": "Aswin"}`)
+		s.Require().NoError(err, name)
+		s.Zero(statusCode, name)
+		s.Equal(decodeReq{Name: "Aswin"}, req, name)
+	}
+}
+
+// @Failed testcase
+func (s *testDecoderSuite) TestDecodeJSONErrors() {
+	testcases := []struct {
+		name        string
+		contentType string
+		body        string
+		err         error
+		statusCode  int
+	}{
+		{"missing content type", "", `{"name": "Aswin"}`, ErrUnsupportedMediaType, http.StatusUnsupportedMediaType},
+		{"text content type", "text/plain", `{"name": "Aswin"}`, ErrUnsupportedMediaType, http.StatusUnsupportedMediaType},
+		{"too large", "application/json", `{"name": "` + strings.Repeat("a", MaxBodyBytes) + `"}`, ErrRequestTooLarge, http.StatusRequestEntityTooLarge},
+		{"unknown field", "application/json", `{"nmae": "Aswin"}`, ErrMalformedRequest, http.StatusBadRequest},
+		{"trailing value", "application/json", `{"name": "Aswin"} {"name": "Aswin"}`, ErrMalformedRequest, http.StatusBadRequest},
+		{"trailing garbage", "application/json", `{"name": "Aswin"} x`, ErrMalformedRequest, http.StatusBadRequest},
+		{"empty body", "application/json", ``, ErrMalformedRequest, http.StatusBadRequest},
+		{"malformed", "application/json", `{"name": `, ErrMalformedRequest, http.StatusBadRequest},
+	}
+
+	for _, tc := range testcases {
+		_, statusCode, err := s.decode(tc.contentType, tc.body)
+		s.ErrorIs(err, tc.err, tc.name)
+		s.Equal(tc.statusCode, statusCode, tc.name)
+	}
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/requestctx"
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// RequestIDHeader carries the ID of a request, generated when the client doesn't send one.
+	RequestIDHeader = "X-Request-ID"
+
+	// ActorHeader carries the actor making a request, recorded in the audit log of its changes.
+	ActorHeader = "X-Actor"
+
+	// AnonymousActor is the actor of the requests without an ActorHeader.
+	AnonymousActor = "anonymous"
+
+	maxRequestIDLength = 64
+	maxActorLength     = 255
+)
+
+// RequestContext is a middleware adding the actor and ID of the request to its context.
+// The request ID is echoed in the response so that clients can correlate it with the audit log.
+func RequestContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(RequestIDHeader)
+		if len(requestID) > maxRequestIDLength {
+			requestID = ""
+		}
+		if requestID == "" {
+			id, err := uuid.NewV7()
+			if err != nil {
+				logger.Log.Warn("Failed to generate request ID", zap.Error(err))
+			} else {
+				requestID = id.String()
+			}
+		}
+
+		actor := r.Header.Get(ActorHeader)
+		if actor == "" {
+			actor = AnonymousActor
+		}
+		if len(actor) > maxActorLength {
+			actor = actor[:maxActorLength]
+		}
+
+		w.Header().Set(RequestIDHeader, requestID)
+		ctx := requestctx.WithActor(r.Context(), actor)
+		ctx = requestctx.WithRequestID(ctx, requestID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
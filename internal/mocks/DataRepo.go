@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	repository "github.com/aswinudhayakumar/account-transactions/pkg/repository"
 	mock "github.com/stretchr/testify/mock"
@@ -14,40 +15,272 @@ type DataRepo struct {
 	mock.Mock
 }
 
+// CaptureHold provides a mock function with given fields: ctx, req
+func (_m *DataRepo) CaptureHold(ctx context.Context, req repository.CaptureHoldReqParams) (*repository.HoldCaptureResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CaptureHold")
+	}
+
+	var r0 *repository.HoldCaptureResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CaptureHoldReqParams) (*repository.HoldCaptureResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CaptureHoldReqParams) *repository.HoldCaptureResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.HoldCaptureResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CaptureHoldReqParams) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateAccount provides a mock function with given fields: ctx, req
-func (_m *DataRepo) CreateAccount(ctx context.Context, req repository.CreateAccountReqParams) error {
+func (_m *DataRepo) CreateAccount(ctx context.Context, req repository.CreateAccountReqParams) (*repository.AccountResponse, error) {
 	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateAccount")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateAccountReqParams) error); ok {
+	var r0 *repository.AccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateAccountReqParams) (*repository.AccountResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateAccountReqParams) *repository.AccountResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.AccountResponse)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateAccountReqParams) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateHold provides a mock function with given fields: ctx, req
+func (_m *DataRepo) CreateHold(ctx context.Context, req repository.CreateHoldReqParams) (*repository.HoldResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateHold")
+	}
+
+	var r0 *repository.HoldResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateHoldReqParams) (*repository.HoldResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateHoldReqParams) *repository.HoldResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.HoldResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateHoldReqParams) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOperationType provides a mock function with given fields: ctx, req
+func (_m *DataRepo) CreateOperationType(ctx context.Context, req repository.CreateOperationTypeReqParams) (*repository.OperationTypeResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOperationType")
+	}
+
+	var r0 *repository.OperationTypeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateOperationTypeReqParams) (*repository.OperationTypeResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateOperationTypeReqParams) *repository.OperationTypeResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.OperationTypeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateOperationTypeReqParams) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // CreateTransaction provides a mock function with given fields: ctx, req
-func (_m *DataRepo) CreateTransaction(ctx context.Context, req repository.CreateTransactionReqParams) error {
+func (_m *DataRepo) CreateTransaction(ctx context.Context, req repository.CreateTransactionReqParams) (*repository.TransactionResponse, error) {
 	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateTransaction")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateTransactionReqParams) error); ok {
+	var r0 *repository.TransactionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateTransactionReqParams) (*repository.TransactionResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateTransactionReqParams) *repository.TransactionResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.TransactionResponse)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateTransactionReqParams) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateTransactions provides a mock function with given fields: ctx, req
+func (_m *DataRepo) CreateTransactions(ctx context.Context, req repository.CreateTransactionsReqParams) ([]repository.BatchTransactionResult, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTransactions")
+	}
+
+	var r0 []repository.BatchTransactionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateTransactionsReqParams) ([]repository.BatchTransactionResult, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateTransactionsReqParams) []repository.BatchTransactionResult); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.BatchTransactionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateTransactionsReqParams) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateTransfer provides a mock function with given fields: ctx, req
+func (_m *DataRepo) CreateTransfer(ctx context.Context, req repository.CreateTransferReqParams) (*repository.TransferResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTransfer")
+	}
+
+	var r0 *repository.TransferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateTransferReqParams) (*repository.TransferResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.CreateTransferReqParams) *repository.TransferResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.TransferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.CreateTransferReqParams) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpireHolds provides a mock function with given fields: ctx, limit
+func (_m *DataRepo) ExpireHolds(ctx context.Context, limit int) (int, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireHolds")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccountBalance provides a mock function with given fields: ctx, accountID, asOf
+func (_m *DataRepo) GetAccountBalance(ctx context.Context, accountID int, asOf *time.Time) (*repository.BalanceResponse, error) {
+	ret := _m.Called(ctx, accountID, asOf)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountBalance")
+	}
+
+	var r0 *repository.BalanceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *time.Time) (*repository.BalanceResponse, error)); ok {
+		return rf(ctx, accountID, asOf)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *time.Time) *repository.BalanceResponse); ok {
+		r0 = rf(ctx, accountID, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.BalanceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *time.Time) error); ok {
+		r1 = rf(ctx, accountID, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // GetAccountByAccountID provides a mock function with given fields: ctx, accountID
@@ -80,6 +313,384 @@ func (_m *DataRepo) GetAccountByAccountID(ctx context.Context, accountID int) (*
 	return r0, r1
 }
 
+// GetAccountByDocumentNumber provides a mock function with given fields: ctx, documentNumber
+func (_m *DataRepo) GetAccountByDocumentNumber(ctx context.Context, documentNumber string) (*repository.AccountResponse, error) {
+	ret := _m.Called(ctx, documentNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountByDocumentNumber")
+	}
+
+	var r0 *repository.AccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*repository.AccountResponse, error)); ok {
+		return rf(ctx, documentNumber)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *repository.AccountResponse); ok {
+		r0 = rf(ctx, documentNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.AccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, documentNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOperationTypeByID provides a mock function with given fields: ctx, operationTypeID
+func (_m *DataRepo) GetOperationTypeByID(ctx context.Context, operationTypeID int) (*repository.OperationTypeResponse, error) {
+	ret := _m.Called(ctx, operationTypeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOperationTypeByID")
+	}
+
+	var r0 *repository.OperationTypeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*repository.OperationTypeResponse, error)); ok {
+		return rf(ctx, operationTypeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *repository.OperationTypeResponse); ok {
+		r0 = rf(ctx, operationTypeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.OperationTypeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, operationTypeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionByID provides a mock function with given fields: ctx, transactionID
+func (_m *DataRepo) GetTransactionByID(ctx context.Context, transactionID int) (*repository.TransactionDetailsResponse, error) {
+	ret := _m.Called(ctx, transactionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionByID")
+	}
+
+	var r0 *repository.TransactionDetailsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*repository.TransactionDetailsResponse, error)); ok {
+		return rf(ctx, transactionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *repository.TransactionDetailsResponse); ok {
+		r0 = rf(ctx, transactionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.TransactionDetailsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, transactionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAuditEntries provides a mock function with given fields: ctx, req
+func (_m *DataRepo) ListAuditEntries(ctx context.Context, req repository.ListAuditEntriesReqParams) (*repository.AuditEntriesPage, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditEntries")
+	}
+
+	var r0 *repository.AuditEntriesPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListAuditEntriesReqParams) (*repository.AuditEntriesPage, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListAuditEntriesReqParams) *repository.AuditEntriesPage); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.AuditEntriesPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListAuditEntriesReqParams) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListInstallments provides a mock function with given fields: ctx, transactionID
+func (_m *DataRepo) ListInstallments(ctx context.Context, transactionID int) ([]repository.InstallmentResponse, error) {
+	ret := _m.Called(ctx, transactionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInstallments")
+	}
+
+	var r0 []repository.InstallmentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]repository.InstallmentResponse, error)); ok {
+		return rf(ctx, transactionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []repository.InstallmentResponse); ok {
+		r0 = rf(ctx, transactionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.InstallmentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, transactionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOperationTypes provides a mock function with given fields: ctx
+func (_m *DataRepo) ListOperationTypes(ctx context.Context) ([]repository.OperationTypeResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOperationTypes")
+	}
+
+	var r0 []repository.OperationTypeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]repository.OperationTypeResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []repository.OperationTypeResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.OperationTypeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTransactions provides a mock function with given fields: ctx, req
+func (_m *DataRepo) ListTransactions(ctx context.Context, req repository.ListTransactionsReqParams) (*repository.TransactionsPage, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTransactions")
+	}
+
+	var r0 *repository.TransactionsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListTransactionsReqParams) (*repository.TransactionsPage, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ListTransactionsReqParams) *repository.TransactionsPage); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.TransactionsPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ListTransactionsReqParams) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReverseTransaction provides a mock function with given fields: ctx, transactionID
+func (_m *DataRepo) ReverseTransaction(ctx context.Context, transactionID int) (*repository.ReversalResponse, error) {
+	ret := _m.Called(ctx, transactionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReverseTransaction")
+	}
+
+	var r0 *repository.ReversalResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*repository.ReversalResponse, error)); ok {
+		return rf(ctx, transactionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *repository.ReversalResponse); ok {
+		r0 = rf(ctx, transactionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.ReversalResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, transactionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StreamStatement provides a mock function with given fields: ctx, req, w
+func (_m *DataRepo) StreamStatement(ctx context.Context, req repository.StatementReqParams, w repository.StatementWriter) error {
+	ret := _m.Called(ctx, req, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamStatement")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.StatementReqParams, repository.StatementWriter) error); ok {
+		r0 = rf(ctx, req, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateAccount provides a mock function with given fields: ctx, req
+func (_m *DataRepo) UpdateAccount(ctx context.Context, req repository.UpdateAccountReqParams) (*repository.AccountResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAccount")
+	}
+
+	var r0 *repository.AccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateAccountReqParams) (*repository.AccountResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateAccountReqParams) *repository.AccountResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.AccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateAccountReqParams) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAccountStatus provides a mock function with given fields: ctx, req
+func (_m *DataRepo) UpdateAccountStatus(ctx context.Context, req repository.UpdateAccountStatusReqParams) (*repository.AccountResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAccountStatus")
+	}
+
+	var r0 *repository.AccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateAccountStatusReqParams) (*repository.AccountResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateAccountStatusReqParams) *repository.AccountResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.AccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateAccountStatusReqParams) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateOperationType provides a mock function with given fields: ctx, req
+func (_m *DataRepo) UpdateOperationType(ctx context.Context, req repository.UpdateOperationTypeReqParams) (*repository.OperationTypeResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOperationType")
+	}
+
+	var r0 *repository.OperationTypeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateOperationTypeReqParams) (*repository.OperationTypeResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UpdateOperationTypeReqParams) *repository.OperationTypeResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.OperationTypeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UpdateOperationTypeReqParams) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VoidHold provides a mock function with given fields: ctx, holdID
+func (_m *DataRepo) VoidHold(ctx context.Context, holdID int) (*repository.HoldResponse, error) {
+	ret := _m.Called(ctx, holdID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VoidHold")
+	}
+
+	var r0 *repository.HoldResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*repository.HoldResponse, error)); ok {
+		return rf(ctx, holdID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *repository.HoldResponse); ok {
+		r0 = rf(ctx, holdID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.HoldResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, holdID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewDataRepo creates a new instance of DataRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDataRepo(t interface {
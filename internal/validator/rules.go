@@ -0,0 +1,112 @@
+package validator
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+)
+
+// Rule validates a value, returning the error message when the value is invalid and an empty string otherwise.
+type Rule[T any] func(value T) string
+
+// Field validates the value of the field with the rules in order, and adds the
+// message of the first failed rule to the validation errors.
+func Field[T any](v *ValidationErrors, field string, value T, rules ...Rule[T]) {
+	for _, rule := range rules {
+		if message := rule(value); message != "" {
+			v.Add(field, message)
+			return
+		}
+	}
+}
+
+// Required validates that the value is not the zero value of its type.
+func Required[T comparable]() Rule[T] {
+	return func(value T) string {
+		var zero T
+		if value == zero {
+			return "This field is required."
+		}
+		return ""
+	}
+}
+
+// NotZero validates that the value is not zero.
+func NotZero[T cmp.Ordered]() Rule[T] {
+	return func(value T) string {
+		var zero T
+		if value == zero {
+			return "Must not be zero."
+		}
+		return ""
+	}
+}
+
+// Positive validates that the value is greater than zero.
+func Positive[T cmp.Ordered]() Rule[T] {
+	return func(value T) string {
+		var zero T
+		if value <= zero {
+			return "Must be greater than zero."
+		}
+		return ""
+	}
+}
+
+// Min validates that the value is at least min.
+func Min[T cmp.Ordered](min T) Rule[T] {
+	return func(value T) string {
+		if value < min {
+			return fmt.Sprintf("Must be at least %v.", min)
+		}
+		return ""
+	}
+}
+
+// Max validates that the value is at most max.
+func Max[T cmp.Ordered](max T) Rule[T] {
+	return func(value T) string {
+		if value > max {
+			return fmt.Sprintf("Must be at most %v.", max)
+		}
+		return ""
+	}
+}
+
+// Length validates that the length of the string is between min and max, inclusive.
+func Length(min, max int) Rule[string] {
+	return func(value string) string {
+		if len(value) < min || len(value) > max {
+			return fmt.Sprintf("Must be between %d and %d characters in length.", min, max)
+		}
+		return ""
+	}
+}
+
+// DecimalScale validates that the decimal string has at most scale decimal places, ignoring trailing zeros.
+func DecimalScale(scale int) Rule[string] {
+	return func(value string) string {
+		_, fraction, _ := strings.Cut(value, ".")
+		if len(strings.TrimRight(fraction, "0")) > scale {
+			return fmt.Sprintf("Must have at most %d decimal places.", scale)
+		}
+		return ""
+	}
+}
+
+// Enum validates that the value is one of the allowed values.
+func Enum[T comparable](values ...T) Rule[T] {
+	return func(value T) string {
+		for _, allowed := range values {
+			if value == allowed {
+				return ""
+			}
+		}
+
+		allowed := make([]string, len(values))
+		for i, v := range values {
+			allowed[i] = fmt.Sprint(v)
+		}
+		return fmt.Sprintf("Must be one of %s.", strings.Join(allowed, ", "))
+	}
+}
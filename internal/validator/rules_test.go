@@ -0,0 +1,77 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// testRulesSuite is a test suite object to test the validation rules.
+type testRulesSuite struct {
+	suite.Suite
+}
+
+// TestRulesSuite is the custom test suite runner for the validation rules.
+func TestRulesSuite(t *testing.T) {
+	suite.Run(t, new(testRulesSuite))
+}
+
+// @Success testcase
+func (s *testRulesSuite) TestRequired() {
+	s.Equal("", Required[string]()("BRL"))
+	s.Equal("This field is required.", Required[string]()(""))
+	s.Equal("", Required[int]()(1))
+	s.Equal("This field is required.", Required[int]()(0))
+}
+
+// @Success testcase
+func (s *testRulesSuite) TestMin() {
+	s.Equal("", Min(1)(1))
+	s.Equal("", Min(1)(2))
+	s.Equal("Must be at least 1.", Min(1)(0))
+	s.Equal("Must be at least 0.01.", Min(0.01)(0.001))
+}
+
+// @Success testcase
+func (s *testRulesSuite) TestMax() {
+	s.Equal("", Max(100)(100))
+	s.Equal("", Max(100)(-1))
+	s.Equal("Must be at most 100.", Max(100)(101))
+}
+
+// @Success testcase
+func (s *testRulesSuite) TestLength() {
+	s.Equal("", Length(1, 3)("a"))
+	s.Equal("", Length(1, 3)("abc"))
+	s.Equal("Must be between 1 and 3 characters in length.", Length(1, 3)(""))
+	s.Equal("Must be between 1 and 3 characters in length.", Length(1, 3)("abcd"))
+}
+
+// @Success testcase
+func (s *testRulesSuite) TestDecimalScale() {
+	s.Equal("", DecimalScale(2)("10"))
+	s.Equal("", DecimalScale(2)("10.5"))
+	s.Equal("", DecimalScale(2)("10.50"))
+
+	// Trailing zeros don't count as decimal places
+	s.Equal("", DecimalScale(2)("10.5000"))
+	s.Equal("Must have at most 2 decimal places.", DecimalScale(2)("10.505"))
+	s.Equal("Must have at most 0 decimal places.", DecimalScale(0)("10.5"))
+}
+
+// @Success testcase
+func (s *testRulesSuite) TestEnum() {
+	s.Equal("", Enum("active", "blocked")("active"))
+	s.Equal("Must be one of active, blocked.", Enum("active", "blocked")("closed"))
+	s.Equal("Must be one of 1, 2.", Enum(1, 2)(3))
+}
+
+// @Success testcase
+func (s *testRulesSuite) TestField() {
+	v := NewValidationErrors()
+
+	// Only the message of the first failed rule is added
+	Field(v, "amount", 0, Required[int](), Min(1))
+	Field(v, "installments", 5, Min(1), Max(12))
+	s.Equal(map[string]string{"amount": "This field is required."}, v.Errors)
+}
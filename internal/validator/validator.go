@@ -2,7 +2,10 @@ package validator
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
 )
 
 type ValidationErrors struct {
@@ -26,9 +29,23 @@ func (v *ValidationErrors) Error() string {
 
 	var builder strings.Builder
 
-	for field, message := range v.Errors {
-		builder.WriteString(fmt.Sprintf("%s: %s; ", field, message))
+	for _, fieldError := range v.FieldErrors() {
+		builder.WriteString(fmt.Sprintf("%s: %s; ", fieldError.Field, fieldError.Message))
 	}
 
 	return strings.TrimSpace(builder.String())
 }
+
+// FieldErrors returns the validation errors as field errors, sorted by field.
+func (v *ValidationErrors) FieldErrors() []writer.FieldError {
+	fieldErrors := make([]writer.FieldError, 0, len(v.Errors))
+	for field, message := range v.Errors {
+		fieldErrors = append(fieldErrors, writer.FieldError{Field: field, Message: message})
+	}
+
+	sort.Slice(fieldErrors, func(i, j int) bool {
+		return fieldErrors[i].Field < fieldErrors[j].Field
+	})
+
+	return fieldErrors
+}
@@ -11,15 +11,43 @@ import (
 
 const (
 	// error codes
-	ErrCodeInvalidRequest  = "invalid_request"
-	ErrCodeUnexpectedError = "unexpected_error"
-	ErrCodeDataNotFound    = "data_not_found"
+	ErrCodeInvalidRequest       = "invalid_request"
+	ErrCodeUnexpectedError      = "unexpected_error"
+	ErrCodeDataNotFound         = "data_not_found"
+	ErrCodeIdempotencyKey       = "idempotency_key_conflict"
+	ErrCodeAlreadyExists        = "already_exists"
+	ErrCodeCreditLimit          = "insufficient_credit_limit"
+	ErrCodeAlreadyReversed      = "already_reversed"
+	ErrCodeNotReversible        = "not_reversible"
+	ErrCodeUnsupportedMediaType = "unsupported_media_type"
+	ErrCodeRequestTooLarge      = "request_too_large"
+	ErrCodeBatchRejected        = "batch_rejected"
+	ErrCodeAccountBlocked       = "account_blocked"
+	ErrCodeAccountClosed        = "account_closed"
+	ErrCodeStatusTransition     = "invalid_status_transition"
+	ErrCodeHoldNotActive        = "hold_not_active"
+	ErrCodeHoldExpired          = "hold_expired"
+	ErrCodeSystemOperationType  = "system_operation_type"
 
 	// error titles
 	ErrTitleInvalidRequestPayload = "Invalid Request Payload"
 	ErrTilteValidationFailed      = "Validation Failed"
 	ErrTitleUnexpectedError       = "Unexpected Error"
 	ErrTitleDataNotFound          = "Requested Data Not Found"
+	ErrTitleIdempotencyKey        = "Idempotency Key Conflict"
+	ErrTitleAlreadyExists         = "Resource Already Exists"
+	ErrTitleCreditLimit           = "Insufficient Credit Limit"
+	ErrTitleAlreadyReversed       = "Transaction Already Reversed"
+	ErrTitleNotReversible         = "Transaction Not Reversible"
+	ErrTitleUnsupportedMediaType  = "Unsupported Media Type"
+	ErrTitleRequestTooLarge       = "Request Too Large"
+	ErrTitleBatchRejected         = "Batch Rejected"
+	ErrTitleAccountBlocked        = "Account Blocked"
+	ErrTitleAccountClosed         = "Account Closed"
+	ErrTitleStatusTransition      = "Invalid Status Transition"
+	ErrTitleHoldNotActive         = "Hold Not Active"
+	ErrTitleHoldExpired           = "Hold Expired"
+	ErrTitleSystemOperationType   = "System Operation Type"
 )
 
 // Errors
@@ -35,11 +63,12 @@ type (
 	}
 
 	ErrorDescription struct {
-		ID     string `json:"id"`
-		Code   string `json:"code"`
-		Title  string `json:"title"`
-		Detail string `json:"detail"`
-		Status int    `json:"status"`
+		ID     string      `json:"id"`
+		Code   string      `json:"code"`
+		Title  string      `json:"title"`
+		Detail string      `json:"detail"`
+		Status int         `json:"status"`
+		Source *FieldError `json:"source,omitempty"`
 	}
 
 	ErrorResponse struct {
@@ -58,10 +87,59 @@ func WriteJSON(w http.ResponseWriter, status int, data interface{}) error {
 	return json.NewEncoder(w).Encode(data)
 }
 
+// StreamWriter writes a response body in parts as they're produced, instead of encoding it at once
+// like WriteJSON. The parts are sent as the buffer of the http.ResponseWriter fills up, or when flushed.
+type StreamWriter struct {
+	w       http.ResponseWriter
+	encoder *json.Encoder
+}
+
+// NewStreamWriter writes the header of a streamed response with the given status code and content type,
+// and returns the writer of its body. The status can't be changed once the streaming started.
+func NewStreamWriter(w http.ResponseWriter, status int, contentType string) (*StreamWriter, error) {
+	if status == 0 {
+		return nil, ErrEmptyHTTPStatus
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	return &StreamWriter{
+		w:       w,
+		encoder: json.NewEncoder(w),
+	}, nil
+}
+
+// Write writes the raw bytes to the response body.
+func (s *StreamWriter) Write(p []byte) (int, error) {
+	return s.w.Write(p)
+}
+
+// WriteJSON writes the data as JSON followed by a newline, i.e. a line of an NDJSON stream.
+func (s *StreamWriter) WriteJSON(data interface{}) error {
+	return s.encoder.Encode(data)
+}
+
+// Flush sends the buffered part of the response body to the client.
+func (s *StreamWriter) Flush() {
+	if flusher, ok := s.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
 // WriteJSONError writes an error response in JSON format.
 func WriteJSONError(w http.ResponseWriter, status int, errDesc ErrorDescription, sources ...FieldError) error {
+	errResps, err := NewErrorDescriptions(status, errDesc, sources...)
+	if err != nil {
+		return err
+	}
+	return WriteJSON(w, status, ErrorResponse{Errors: errResps})
+}
+
+// NewErrorDescriptions returns the error descriptions of an error response with the given status,
+// one per source when the error has field error sources, detailed with the message of the source.
+func NewErrorDescriptions(status int, errDesc ErrorDescription, sources ...FieldError) ([]ErrorDescription, error) {
 	if errDesc.Title == "" {
-		return ErrEmptyErrorMessage
+		return nil, ErrEmptyErrorMessage
 	}
 
 	errDesc.Status = status
@@ -71,18 +149,18 @@ func WriteJSONError(w http.ResponseWriter, status int, errDesc ErrorDescription,
 		for _, source := range sources {
 			resp, err := configureErrorResponse(errDesc, &source)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			errResps = append(errResps, resp)
 		}
 	} else {
 		resp, err := configureErrorResponse(errDesc, nil)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		errResps = append(errResps, resp)
 	}
-	return WriteJSON(w, status, ErrorResponse{Errors: errResps})
+	return errResps, nil
 }
 
 func configureErrorResponse(resp ErrorDescription, source *FieldError) (ErrorDescription, error) {
@@ -94,5 +172,13 @@ func configureErrorResponse(resp ErrorDescription, source *FieldError) (ErrorDes
 		resp.ID = id.String()
 	}
 
+	// Copy the source, the caller may reuse it for the next error.
+	// The detail of a field error is the message of its field.
+	if source != nil {
+		fieldError := *source
+		resp.Source = &fieldError
+		resp.Detail = fieldError.Message
+	}
+
 	return resp, nil
 }
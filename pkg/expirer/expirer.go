@@ -0,0 +1,73 @@
+package expirer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"go.uber.org/zap"
+)
+
+// DefaultBatchSize is the default number of holds expired in a single database transaction.
+const DefaultBatchSize = 500
+
+// Errors
+var (
+	ErrInvalidInterval  = errors.New("expiry interval must be positive")
+	ErrInvalidBatchSize = errors.New("batch size must be a positive integer")
+)
+
+// HoldExpirer is the background worker expiring the holds past their TTL, releasing the credit they hold.
+type HoldExpirer struct {
+	DataRepo  repository.DataRepo
+	Interval  time.Duration
+	BatchSize int
+}
+
+// Run expires the holds every Interval until the context is done. A failed run is logged and retried
+// at the next interval.
+func (e *HoldExpirer) Run(ctx context.Context) error {
+	if e.Interval <= 0 {
+		return ErrInvalidInterval
+	}
+	if e.BatchSize <= 0 {
+		return ErrInvalidBatchSize
+	}
+
+	ticker := time.NewTicker(e.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			expired, err := e.ExpireHolds(ctx)
+			if err != nil {
+				logger.Log.Error("Failed to expire holds", zap.Error(err))
+			}
+			if expired > 0 {
+				logger.Log.Info("Expired holds", zap.Int("expired", expired))
+			}
+		}
+	}
+}
+
+// ExpireHolds expires the holds past their TTL in batches, each batch committed on its own, until
+// a batch isn't full. It returns the number of holds expired, including the batches expired before an error.
+func (e *HoldExpirer) ExpireHolds(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		expired, err := e.DataRepo.ExpireHolds(ctx, e.BatchSize)
+		if err != nil {
+			return total, err
+		}
+
+		total += expired
+		if expired < e.BatchSize {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package expirer
+
+import (
+	"context"
+	"errors"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// testHoldExpirerSuite is a test suite object to test the HoldExpirer.
+type testHoldExpirerSuite struct {
+	suite.Suite
+
+	dataRepo *mocks.DataRepo
+	expirer  *HoldExpirer
+}
+
+// SetupTest setups and initializes the testHoldExpirerSuite.
+func (s *testHoldExpirerSuite) SetupTest() {
+	s.dataRepo = new(mocks.DataRepo)
+	s.expirer = &HoldExpirer{
+		DataRepo:  s.dataRepo,
+		Interval:  10 * time.Millisecond,
+		BatchSize: 2,
+	}
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestHoldExpirerSuite is the custom test suite runner for the HoldExpirer.
+func TestHoldExpirerSuite(t *testing.T) {
+	suite.Run(t, new(testHoldExpirerSuite))
+}
+
+// @Success testcase
+func (s *testHoldExpirerSuite) TestExpireHoldsInBatches() {
+	s.dataRepo.Mock.On("ExpireHolds", mock.Anything, 2).Return(2, nil).Twice()
+	s.dataRepo.Mock.On("ExpireHolds", mock.Anything, 2).Return(1, nil).Once()
+
+	expired, err := s.expirer.ExpireHolds(context.Background())
+	s.Require().NoError(err)
+	s.Equal(5, expired)
+	s.dataRepo.AssertExpectations(s.T())
+}
+
+// @Failed testcase
+func (s *testHoldExpirerSuite) TestExpireHoldsError() {
+	s.dataRepo.Mock.On("ExpireHolds", mock.Anything, 2).Return(2, nil).Once()
+	s.dataRepo.Mock.On("ExpireHolds", mock.Anything, 2).Return(0, errors.New("something went wrong")).Once()
+
+	// The batches expired before the error are counted
+	expired, err := s.expirer.ExpireHolds(context.Background())
+	s.Require().Error(err)
+	s.Equal(2, expired)
+	s.dataRepo.AssertExpectations(s.T())
+}
+
+// @Success testcase
+func (s *testHoldExpirerSuite) TestRunUntilDone() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// A failed run is retried at the next interval
+	s.dataRepo.Mock.On("ExpireHolds", mock.Anything, 2).Return(0, errors.New("something went wrong")).Once()
+	s.dataRepo.Mock.On("ExpireHolds", mock.Anything, 2).Return(1, nil).Once().Run(func(mock.Arguments) {
+		cancel()
+	})
+
+	done := make(chan error)
+	go func() {
+		done <- s.expirer.Run(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		s.Require().NoError(err)
+	case <-time.After(5 * time.Second):
+		s.FailNow("the expirer didn't stop when its context was done")
+	}
+	s.dataRepo.AssertExpectations(s.T())
+}
+
+// @Failed testcase
+func (s *testHoldExpirerSuite) TestRunInvalidConfig() {
+	s.expirer.Interval = 0
+	s.ErrorIs(s.expirer.Run(context.Background()), ErrInvalidInterval)
+
+	s.expirer.Interval = time.Minute
+	s.expirer.BatchSize = 0
+	s.ErrorIs(s.expirer.Run(context.Background()), ErrInvalidBatchSize)
+}
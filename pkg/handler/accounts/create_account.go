@@ -1,12 +1,14 @@
 package handler
 
 import (
-	"encoding/json"
+	"errors"
 	"net/http"
 
+	"github.com/aswinudhayakumar/account-transactions/internal/decoder"
 	"github.com/aswinudhayakumar/account-transactions/internal/logger"
 	"github.com/aswinudhayakumar/account-transactions/internal/validator"
 	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	trxHandler "github.com/aswinudhayakumar/account-transactions/pkg/handler/transactions"
 	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
 	"go.uber.org/zap"
 )
@@ -15,43 +17,56 @@ import (
 func (h *accountsHandler) CreateAccount(w http.ResponseWriter, r *http.Request) {
 	// Decode the request params
 	var req CreateAccountReqParams
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	if err := decoder.DecodeJSON(w, r, &req); err != nil {
 		logger.Log.Error("Failed to decode HTTP request payload", zap.Error(err))
-		writer.WriteJSONError(
-			w,
-			http.StatusBadRequest,
-			writer.ErrorDescription{
-				Title:  writer.ErrTitleInvalidRequestPayload,
-				Code:   writer.ErrCodeInvalidRequest,
-				Detail: "The request payload is malformed or invalid.",
-			},
-		)
+		statusCode, errDesc := decoder.ErrorResponse(err)
+		writer.WriteJSONError(w, statusCode, errDesc)
 		return
 	}
 
+	// Accounts are created in the default currency unless stated otherwise
+	if req.Currency == "" {
+		req.Currency = repository.DefaultCurrency
+	}
+
 	// Validate the request params
-	if validationErrs := validateCreateAccountRequest(req); validationErrs != nil {
+	creditLimit, validationErrs := validateCreateAccountRequest(req)
+	if validationErrs != nil {
 		logger.Log.Error("Validation failed for CreateAccount request", zap.String("validation_errors", validationErrs.Error()))
 		writer.WriteJSONError(
 			w,
 			http.StatusBadRequest,
 			writer.ErrorDescription{
-				Title:  writer.ErrTilteValidationFailed,
-				Code:   writer.ErrCodeInvalidRequest,
-				Detail: validationErrs.Error(),
+				Title: writer.ErrTilteValidationFailed,
+				Code:  writer.ErrCodeInvalidRequest,
 			},
+			validationErrs.FieldErrors()...,
 		)
 		return
 	}
 
 	// Create new account
-	err := h.DataRepo.CreateAccount(
-		r.Context(),
-		repository.CreateAccountReqParams{
-			DocumentNumber: req.DocumentNumber,
-		},
-	)
+	dbResp, err := h.DataRepo.CreateAccount(r.Context(), repository.CreateAccountReqParams{
+		DocumentNumber: req.DocumentNumber,
+		Currency:       req.Currency,
+		CreditLimit:    creditLimit.Amount,
+	})
 	if err != nil {
+		// Return 409 conflict error if the document number is already used
+		if errors.Is(err, repository.ErrDocumentNumberAlreadyExists) {
+			logger.Log.Error("Failed to create account", zap.Error(err))
+			writer.WriteJSONError(
+				w,
+				http.StatusConflict,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleAlreadyExists,
+					Code:   writer.ErrCodeAlreadyExists,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
 		logger.Log.Error("Database call failed for CreateAccount request", zap.Error(err))
 		writer.WriteJSONError(
 			w,
@@ -66,9 +81,8 @@ func (h *accountsHandler) CreateAccount(w http.ResponseWriter, r *http.Request)
 	}
 
 	// Send success response
-	resp := CreateAccountResponse{
-		Status: StatusSuccess,
-	}
+	resp := newAccountResponse(dbResp)
+	w.Header().Set("Location", accountLocation(resp.AccountID))
 	if err := writer.WriteJSON(w, http.StatusCreated, resp); err != nil {
 		logger.Log.Error("Error writting success response for CreateAccount request", zap.Error(err))
 		writer.WriteJSONError(
@@ -84,17 +98,34 @@ func (h *accountsHandler) CreateAccount(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// validateCreateAccountRequest validates the request object for CreateAccount API handler.
-func validateCreateAccountRequest(req CreateAccountReqParams) *validator.ValidationErrors {
+// validateCreateAccountRequest validates the request object for CreateAccount API handler
+// and returns the credit limit in minor units.
+func validateCreateAccountRequest(req CreateAccountReqParams) (repository.Money, *validator.ValidationErrors) {
 	errors := validator.NewValidationErrors()
 
-	if len(req.DocumentNumber) < 3 || len(req.DocumentNumber) > 255 {
-		errors.Add("document_number", "Document number must be between 3 and 255 characters in length.")
+	validator.Field(errors, "document_number", req.DocumentNumber, validator.Length(3, 255))
+	validator.Field(errors, "currency", req.Currency, validator.Enum(repository.SupportedCurrencies()...))
+
+	// Debits can't exceed the credit limit, so an account must be created with one
+	var creditLimit repository.Money
+	if req.CreditLimit == nil {
+		errors.Add("credit_limit", "This field is required.")
+	} else {
+		creditLimit = parseCreditLimit(errors, *req.CreditLimit)
+		if _, invalidCurrency := errors.Errors["credit_limit.currency"]; !invalidCurrency {
+			validator.Field(errors, "credit_limit.currency", req.CreditLimit.Currency, validator.Enum(req.Currency))
+		}
 	}
 
 	if len(errors.Errors) > 0 {
-		return errors
+		return repository.Money{}, errors
 	}
 
-	return nil
+	return creditLimit, nil
+}
+
+// parseCreditLimit validates the credit limit of an account with the decimal places of its currency
+// and converts it to minor units.
+func parseCreditLimit(errors *validator.ValidationErrors, req trxHandler.AmountReqParams) repository.Money {
+	return trxHandler.ParseAmount(errors, "credit_limit", req, validator.Min[int64](0))
 }
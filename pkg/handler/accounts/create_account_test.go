@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aswinudhayakumar/account-transactions/internal/logger"
 	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
@@ -55,17 +56,30 @@ func TestCreateAccountSuite(t *testing.T) {
 // @Success testcase - statusCode (201)
 func (s *testCreateAccountSuite) TestCreateAccountSuccess() {
 	documentNumber := "12345678900"
+	t := time.Now()
 	s.dataRepo.Mock.On("CreateAccount", mock.Anything, repository.CreateAccountReqParams{
 		DocumentNumber: documentNumber,
-	}).Return(nil)
+		Currency:       repository.DefaultCurrency,
+		CreditLimit:    500000,
+	}).Return(&repository.AccountResponse{
+		AccountID:      1,
+		DocumentNumber: documentNumber,
+		Currency:       repository.DefaultCurrency,
+		CreatedAt:      t,
+		UpdatedAt:      t,
+	}, nil)
 
 	reqBody := fmt.Sprintf(`{
-		"document_number": "%s"
+		"document_number": "%s",
+		"credit_limit": {"value": "5000.00", "currency": "BRL"}
 	}`, documentNumber)
 	req := httptest.NewRequest(http.MethodPost, createAccountEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
 
 	s.router.ServeHTTP(s.recorder, req)
 	s.Equal(http.StatusCreated, s.recorder.Code)
+	s.Equal("/app/v1/accounts/1", s.recorder.Header().Get("Location"))
+	s.Contains(s.recorder.Body.String(), `"account_id":1`)
 }
 
 // @Failed testcase - statusCode (400)
@@ -77,6 +91,7 @@ func (s *testCreateAccountSuite) TestCreateAccountInvalidRequest() {
 		"document_number": "%s"
 	`, documentNumber)
 	req := httptest.NewRequest(http.MethodPost, createAccountEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
 
 	s.router.ServeHTTP(s.recorder, req)
 	s.Equal(http.StatusBadRequest, s.recorder.Code)
@@ -91,6 +106,7 @@ func (s *testCreateAccountSuite) TestCreateAccountInvalidRequestPayload() {
 		"document_number": "%s"
 	}`, documentNumber)
 	req := httptest.NewRequest(http.MethodPost, createAccountEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
 
 	s.router.ServeHTTP(s.recorder, req)
 	s.Equal(http.StatusBadRequest, s.recorder.Code)
@@ -101,13 +117,193 @@ func (s *testCreateAccountSuite) TestCreateAccountInternalServerError() {
 	documentNumber := "12345678900"
 	s.dataRepo.Mock.On("CreateAccount", mock.Anything, repository.CreateAccountReqParams{
 		DocumentNumber: documentNumber,
-	}).Return(errors.New("something went wrong"))
+		Currency:       repository.DefaultCurrency,
+		CreditLimit:    500000,
+	}).Return(nil, errors.New("something went wrong"))
 
 	reqBody := fmt.Sprintf(`{
-		"document_number": "%s"
+		"document_number": "%s",
+		"credit_limit": {"value": "5000.00", "currency": "BRL"}
 	}`, documentNumber)
 	req := httptest.NewRequest(http.MethodPost, createAccountEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
 
 	s.router.ServeHTTP(s.recorder, req)
 	s.Equal(http.StatusInternalServerError, s.recorder.Code)
 }
+
+// @Failed testcase - statusCode (409)
+func (s *testCreateAccountSuite) TestCreateAccountDocumentNumberConflict() {
+	documentNumber := "12345678900"
+	s.dataRepo.Mock.On("CreateAccount", mock.Anything, repository.CreateAccountReqParams{
+		DocumentNumber: documentNumber,
+		Currency:       repository.DefaultCurrency,
+		CreditLimit:    500000,
+	}).Return(nil, repository.ErrDocumentNumberAlreadyExists)
+
+	reqBody := fmt.Sprintf(`{
+		"document_number": "%s",
+		"credit_limit": {"value": "5000.00", "currency": "BRL"}
+	}`, documentNumber)
+	req := httptest.NewRequest(http.MethodPost, createAccountEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusConflict, s.recorder.Code)
+}
+
+// @Success testcase - statusCode (201)
+func (s *testCreateAccountSuite) TestCreateAccountWithCurrencySuccess() {
+	documentNumber := "12345678900"
+	t := time.Now()
+	s.dataRepo.Mock.On("CreateAccount", mock.Anything, repository.CreateAccountReqParams{
+		DocumentNumber: documentNumber,
+		Currency:       "USD",
+		CreditLimit:    500000,
+	}).Return(&repository.AccountResponse{
+		AccountID:      1,
+		DocumentNumber: documentNumber,
+		Currency:       "USD",
+		CreatedAt:      t,
+		UpdatedAt:      t,
+	}, nil)
+
+	reqBody := fmt.Sprintf(`{
+		"document_number": "%s",
+		"currency": "USD",
+		"credit_limit": {"value": "5000.00", "currency": "USD"}
+	}`, documentNumber)
+	req := httptest.NewRequest(http.MethodPost, createAccountEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusCreated, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"currency":"USD"`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateAccountSuite) TestCreateAccountUnsupportedCurrency() {
+	reqBody := `{
+		"document_number": "12345678900",
+		"currency": "XYZ"
+	}`
+	req := httptest.NewRequest(http.MethodPost, createAccountEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Success testcase - statusCode (201)
+func (s *testCreateAccountSuite) TestCreateAccountWithCreditLimitSuccess() {
+	documentNumber := "12345678900"
+	t := time.Now()
+	s.dataRepo.Mock.On("CreateAccount", mock.Anything, repository.CreateAccountReqParams{
+		DocumentNumber: documentNumber,
+		Currency:       repository.DefaultCurrency,
+		CreditLimit:    500000,
+	}).Return(&repository.AccountResponse{
+		AccountID:            1,
+		DocumentNumber:       documentNumber,
+		Currency:             repository.DefaultCurrency,
+		CreditLimit:          500000,
+		AvailableCreditLimit: 500000,
+		CreatedAt:            t,
+		UpdatedAt:            t,
+	}, nil)
+
+	reqBody := fmt.Sprintf(`{
+		"document_number": "%s",
+		"credit_limit": {"value": "5000.00", "currency": "BRL"}
+	}`, documentNumber)
+	req := httptest.NewRequest(http.MethodPost, createAccountEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusCreated, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"credit_limit":{"value":"5000.00","currency":"BRL"}`)
+	s.Contains(s.recorder.Body.String(), `"available_credit_limit":{"value":"5000.00","currency":"BRL"}`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateAccountSuite) TestCreateAccountMissingCreditLimit() {
+	reqBody := `{"document_number": "12345678900"}`
+	req := httptest.NewRequest(http.MethodPost, createAccountEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"credit_limit","message":"This field is required."}`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateAccountSuite) TestCreateAccountCreditLimitCurrencyMismatch() {
+	reqBody := `{
+		"document_number": "12345678900",
+		"currency": "BRL",
+		"credit_limit": {"value": "5000.00", "currency": "USD"}
+	}`
+	req := httptest.NewRequest(http.MethodPost, createAccountEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateAccountSuite) TestCreateAccountCreditLimitTooManyDecimals() {
+	reqBody := `{
+		"document_number": "12345678900",
+		"currency": "JPY",
+		"credit_limit": {"value": "5000.5", "currency": "JPY"}
+	}`
+	req := httptest.NewRequest(http.MethodPost, createAccountEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"credit_limit.value","message":"Must have at most 0 decimal places."}`)
+	s.dataRepo.AssertNotCalled(s.T(), "CreateAccount", mock.Anything, mock.Anything)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateAccountSuite) TestCreateAccountUnknownField() {
+	reqBody := `{"document_number": "12345678900", "curency": "USD"}`
+	req := httptest.NewRequest(http.MethodPost, createAccountEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.dataRepo.AssertNotCalled(s.T(), "CreateAccount", mock.Anything, mock.Anything)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateAccountSuite) TestCreateAccountTrailingData() {
+	reqBody := `{"document_number": "12345678900"} {"document_number": "98765432100"}`
+	req := httptest.NewRequest(http.MethodPost, createAccountEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.dataRepo.AssertNotCalled(s.T(), "CreateAccount", mock.Anything, mock.Anything)
+}
+
+// @Failed testcase - statusCode (413)
+func (s *testCreateAccountSuite) TestCreateAccountRequestTooLarge() {
+	reqBody := fmt.Sprintf(`{"document_number": "%s"}`, strings.Repeat("1", 2<<20))
+	req := httptest.NewRequest(http.MethodPost, createAccountEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusRequestEntityTooLarge, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (415)
+func (s *testCreateAccountSuite) TestCreateAccountUnsupportedMediaType() {
+	reqBody := `{"document_number": "12345678900"}`
+	req := httptest.NewRequest(http.MethodPost, createAccountEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "text/plain")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusUnsupportedMediaType, s.recorder.Code)
+}
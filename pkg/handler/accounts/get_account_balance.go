@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"go.uber.org/zap"
+)
+
+// GetAccountBalance retrieves the balance of an account using the Account ID.
+// An optional RFC3339 as_of query param returns the balance at that point in time.
+func (h *accountsHandler) GetAccountBalance(w http.ResponseWriter, r *http.Request) {
+	// path : "app/v1/accounts/{id}/balance"
+	path := r.URL.Path
+	parts := strings.Split(path, "/")
+	accountIDStr := parts[4]
+
+	// Get accountID from request URL
+	accountID, err := strconv.Atoi(accountIDStr)
+	if err != nil {
+		logger.Log.Error("Failed to get query param from request URL", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title:  "Invalid Account ID",
+				Code:   writer.ErrCodeInvalidRequest,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Get the optional as_of timestamp from request URL
+	var asOf *time.Time
+	if asOfStr := r.URL.Query().Get("as_of"); asOfStr != "" {
+		t, err := time.Parse(time.RFC3339, asOfStr)
+		if err != nil {
+			logger.Log.Error("Failed to parse as_of query param", zap.Error(err))
+			writer.WriteJSONError(
+				w,
+				http.StatusBadRequest,
+				writer.ErrorDescription{
+					Title:  "Invalid As Of Timestamp",
+					Code:   writer.ErrCodeInvalidRequest,
+					Detail: "The as_of query param must be a RFC3339 timestamp.",
+				},
+			)
+			return
+		}
+		asOf = &t
+	}
+
+	// Get the account balance
+	dbResp, err := h.DataRepo.GetAccountBalance(r.Context(), accountID, asOf)
+	if err != nil {
+		logger.Log.Error("Database call failed for GetAccountBalance request", zap.Error(err))
+		// Return 404 error if data not found
+		if errors.Is(err, sql.ErrNoRows) {
+			writer.WriteJSONError(
+				w,
+				http.StatusNotFound,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleDataNotFound,
+					Code:   writer.ErrCodeDataNotFound,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		// Return 500 internal server error for other errors
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Send success response
+	resp := BalanceResponse{
+		AccountID: dbResp.AccountID,
+		Balance:   repository.Money{Amount: dbResp.Balance, Currency: dbResp.Currency},
+		AsOf:      dbResp.AsOf.Format(time.RFC3339),
+	}
+	if err := writer.WriteJSON(w, http.StatusOK, resp); err != nil {
+		logger.Log.Error("Error writting success response for GetAccountBalance request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// testGetAccountBalanceSuite is a test suite object to test GetAccountBalance API handler.
+type testGetAccountBalanceSuite struct {
+	suite.Suite
+
+	dataRepo        *mocks.DataRepo
+	router          *chi.Mux
+	accountsHandler AccountsHandler
+	recorder        *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testGetAccountBalanceSuite.
+func (s *testGetAccountBalanceSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.accountsHandler = NewAccountsHandler(s.dataRepo)
+
+	s.router = chi.NewRouter()
+	s.router.Get("/app/v1/accounts/{id}/balance", s.accountsHandler.GetAccountBalance)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestGetAccountBalanceSuite is the custom test suite runner for GetAccountBalance API handler.
+func TestGetAccountBalanceSuite(t *testing.T) {
+	suite.Run(t, new(testGetAccountBalanceSuite))
+}
+
+// @Success testcase - statusCode (200)
+func (s *testGetAccountBalanceSuite) TestGetAccountBalanceSuccess() {
+	s.dataRepo.Mock.On("GetAccountBalance", mock.Anything, 1, (*time.Time)(nil)).
+		Return(&repository.BalanceResponse{
+			AccountID: 1,
+			Balance:   -7550,
+			Currency:  "BRL",
+			AsOf:      time.Now(),
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/accounts/1/balance", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"balance":{"value":"-75.50","currency":"BRL"}`)
+}
+
+// @Success testcase - statusCode (200)
+func (s *testGetAccountBalanceSuite) TestGetAccountBalanceAsOfSuccess() {
+	asOf := time.Date(2025, 1, 31, 23, 59, 59, 0, time.UTC)
+	s.dataRepo.Mock.On("GetAccountBalance", mock.Anything, 1, &asOf).
+		Return(&repository.BalanceResponse{
+			AccountID: 1,
+			Balance:   1000,
+			Currency:  "BRL",
+			AsOf:      asOf,
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/accounts/1/balance?as_of=2025-01-31T23:59:59Z", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"as_of":"2025-01-31T23:59:59Z"`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testGetAccountBalanceSuite) TestGetAccountBalanceInvalidAsOf() {
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/accounts/1/balance?as_of=yesterday", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (404)
+func (s *testGetAccountBalanceSuite) TestGetAccountBalanceDataNotFound() {
+	s.dataRepo.Mock.On("GetAccountBalance", mock.Anything, 1, (*time.Time)(nil)).
+		Return(nil, sql.ErrNoRows)
+
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/accounts/1/balance", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusNotFound, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testGetAccountBalanceSuite) TestGetAccountBalanceInternalServerError() {
+	s.dataRepo.Mock.On("GetAccountBalance", mock.Anything, 1, (*time.Time)(nil)).
+		Return(nil, errors.New("something went wrong"))
+
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/accounts/1/balance", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/aswinudhayakumar/account-transactions/internal/logger"
 	"github.com/aswinudhayakumar/account-transactions/internal/writer"
@@ -68,12 +67,7 @@ func (h *accountsHandler) GetAccountByAccountID(w http.ResponseWriter, r *http.R
 	}
 
 	// Send success response
-	resp := AccountResponse{
-		AccountID:      dbResp.AccountID,
-		DocumentNumber: dbResp.DocumentNumber,
-		CreatedAt:      dbResp.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:      dbResp.UpdatedAt.Format(time.RFC3339),
-	}
+	resp := newAccountResponse(dbResp)
 	if err := writer.WriteJSON(w, http.StatusOK, resp); err != nil {
 		logger.Log.Error("Error writting success response for GetAccountByAccountID request", zap.Error(err))
 		writer.WriteJSONError(
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"go.uber.org/zap"
+)
+
+// GetAccountByDocumentNumber retrieves an account using the document_number query param.
+func (h *accountsHandler) GetAccountByDocumentNumber(w http.ResponseWriter, r *http.Request) {
+	// Get documentNumber from request URL
+	documentNumber := r.URL.Query().Get("document_number")
+	if documentNumber == "" {
+		logger.Log.Error("Missing document_number query param in request URL")
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title:  "Invalid Document Number",
+				Code:   writer.ErrCodeInvalidRequest,
+				Detail: "The document_number query param is required.",
+			},
+		)
+		return
+	}
+
+	// Get the account using document number
+	dbResp, err := h.DataRepo.GetAccountByDocumentNumber(r.Context(), documentNumber)
+	if err != nil {
+		logger.Log.Error("Database call failed for GetAccountByDocumentNumber request", zap.Error(err))
+		// Return 404 error if data not found
+		if errors.Is(err, sql.ErrNoRows) {
+			writer.WriteJSONError(
+				w,
+				http.StatusNotFound,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleDataNotFound,
+					Code:   writer.ErrCodeDataNotFound,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		// Return 500 internal server error for other errors
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Send success response
+	resp := newAccountResponse(dbResp)
+	if err := writer.WriteJSON(w, http.StatusOK, resp); err != nil {
+		logger.Log.Error("Error writting success response for GetAccountByDocumentNumber request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+}
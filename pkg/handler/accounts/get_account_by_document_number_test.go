@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	getAccountByDocumentNumberEndpoint = "/app/v1/accounts?document_number="
+)
+
+// testGetAccountByDocumentNumberSuite is a test suite object to test GetAccountByDocumentNumber API handler.
+type testGetAccountByDocumentNumberSuite struct {
+	suite.Suite
+
+	dataRepo        *mocks.DataRepo
+	router          *chi.Mux
+	accountsHandler AccountsHandler
+	recorder        *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testGetAccountByDocumentNumberSuite.
+func (s *testGetAccountByDocumentNumberSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.accountsHandler = NewAccountsHandler(s.dataRepo)
+
+	s.router = chi.NewRouter()
+	s.router.Get("/app/v1/accounts", s.accountsHandler.GetAccountByDocumentNumber)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestGetAccountByDocumentNumberSuite is the custom test suite runner for GetAccountByDocumentNumber API handler.
+func TestGetAccountByDocumentNumberSuite(t *testing.T) {
+	suite.Run(t, new(testGetAccountByDocumentNumberSuite))
+}
+
+// @Success testcase - statusCode (200)
+func (s *testGetAccountByDocumentNumberSuite) TestGetAccountByDocumentNumberSuccess() {
+	documentNumber := "12345678900"
+	t := time.Now()
+
+	s.dataRepo.Mock.On("GetAccountByDocumentNumber", mock.Anything, documentNumber).
+		Return(&repository.AccountResponse{
+			AccountID:      1,
+			DocumentNumber: documentNumber,
+			CreatedAt:      t,
+			UpdatedAt:      t,
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, getAccountByDocumentNumberEndpoint+documentNumber, nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testGetAccountByDocumentNumberSuite) TestGetAccountByDocumentNumberMissing() {
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/accounts", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (404)
+func (s *testGetAccountByDocumentNumberSuite) TestGetAccountByDocumentNumberDataNotFound() {
+	documentNumber := "12345678900"
+
+	s.dataRepo.Mock.On("GetAccountByDocumentNumber", mock.Anything, documentNumber).
+		Return(nil, sql.ErrNoRows)
+
+	req := httptest.NewRequest(http.MethodGet, getAccountByDocumentNumberEndpoint+documentNumber, nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusNotFound, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testGetAccountByDocumentNumberSuite) TestGetAccountByDocumentNumberInternalServerError() {
+	documentNumber := "12345678900"
+
+	s.dataRepo.Mock.On("GetAccountByDocumentNumber", mock.Anything, documentNumber).
+		Return(nil, errors.New("something went wrong"))
+
+	req := httptest.NewRequest(http.MethodGet, getAccountByDocumentNumberEndpoint+documentNumber, nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
@@ -10,6 +10,10 @@ import (
 type AccountsHandler interface {
 	CreateAccount(w http.ResponseWriter, r *http.Request)
 	GetAccountByAccountID(w http.ResponseWriter, r *http.Request)
+	GetAccountByDocumentNumber(w http.ResponseWriter, r *http.Request)
+	UpdateAccount(w http.ResponseWriter, r *http.Request)
+	UpdateAccountStatus(w http.ResponseWriter, r *http.Request)
+	GetAccountBalance(w http.ResponseWriter, r *http.Request)
 }
 
 // accountsHandler object.
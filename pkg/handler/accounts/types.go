@@ -1,21 +1,72 @@
 package handler
 
-const StatusSuccess = "success"
+import (
+	"fmt"
+	"time"
+
+	trxHandler "github.com/aswinudhayakumar/account-transactions/pkg/handler/transactions"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+)
+
+// accountLocationFormat is the format of the Location header for a created account.
+const accountLocationFormat = "/app/v1/accounts/%d"
 
 // CreateAccountReqParams is the request object for CreateAccount API.
+// Currency is an ISO-4217 currency code, defaulting to repository.DefaultCurrency,
+// and the required credit limit must be in the same currency.
 type CreateAccountReqParams struct {
-	DocumentNumber string `json:"document_number"`
+	DocumentNumber string                      `json:"document_number"`
+	Currency       string                      `json:"currency"`
+	CreditLimit    *trxHandler.AmountReqParams `json:"credit_limit"`
 }
 
-// CreateAccountResponse is the response object for CreateAccount API.
-type CreateAccountResponse struct {
+// UpdateAccountReqParams is the request object for UpdateAccount API.
+// The available credit limit moves by the change of the credit limit.
+type UpdateAccountReqParams struct {
+	CreditLimit *trxHandler.AmountReqParams `json:"credit_limit"`
+}
+
+// UpdateAccountStatusReqParams is the request object for UpdateAccountStatus API.
+// Reason is recorded with the status change.
+type UpdateAccountStatusReqParams struct {
 	Status string `json:"status"`
+	Reason string `json:"reason"`
 }
 
 // AccountResponse is the response object, which holds Account data.
 type AccountResponse struct {
-	AccountID      int    `json:"account_id"`
-	DocumentNumber string `json:"document_number"`
-	CreatedAt      string `json:"created_at"`
-	UpdatedAt      string `json:"updated_at"`
+	AccountID            int              `json:"account_id"`
+	DocumentNumber       string           `json:"document_number"`
+	Currency             string           `json:"currency"`
+	CreditLimit          repository.Money `json:"credit_limit"`
+	AvailableCreditLimit repository.Money `json:"available_credit_limit"`
+	Status               string           `json:"status"`
+	CreatedAt            string           `json:"created_at"`
+	UpdatedAt            string           `json:"updated_at"`
+}
+
+// BalanceResponse is the response object, which holds the balance of an Account.
+type BalanceResponse struct {
+	AccountID int              `json:"account_id"`
+	Balance   repository.Money `json:"balance"`
+	AsOf      string           `json:"as_of"`
+}
+
+// newAccountResponse converts the Account data from database to the API response object.
+func newAccountResponse(dbResp *repository.AccountResponse) AccountResponse {
+	return AccountResponse{
+		AccountID:            dbResp.AccountID,
+		DocumentNumber:       dbResp.DocumentNumber,
+		Currency:             dbResp.Currency,
+		CreditLimit:          dbResp.CreditLimitMoney(),
+		AvailableCreditLimit: dbResp.AvailableCreditLimitMoney(),
+		Status:               dbResp.Status,
+		CreatedAt:            dbResp.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:            dbResp.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// accountLocation returns the URL path of the given account.
+func accountLocation(accountID int) string {
+	return fmt.Sprintf(accountLocationFormat, accountID)
 }
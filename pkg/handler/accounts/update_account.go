@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/decoder"
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/validator"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"go.uber.org/zap"
+)
+
+// UpdateAccount handles the partial update of an account.
+func (h *accountsHandler) UpdateAccount(w http.ResponseWriter, r *http.Request) {
+	// path : "app/v1/accounts/{id}"
+	path := r.URL.Path
+	parts := strings.Split(path, "/")
+	accountIDStr := parts[4]
+
+	// Get accountID from request URL
+	accountID, err := strconv.Atoi(accountIDStr)
+	if err != nil {
+		logger.Log.Error("Failed to get query param from request URL", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title:  "Invalid Account ID",
+				Code:   writer.ErrCodeInvalidRequest,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Decode the request params
+	var req UpdateAccountReqParams
+	if err := decoder.DecodeJSON(w, r, &req); err != nil {
+		logger.Log.Error("Failed to decode HTTP request payload", zap.Error(err))
+		statusCode, errDesc := decoder.ErrorResponse(err)
+		writer.WriteJSONError(w, statusCode, errDesc)
+		return
+	}
+
+	// Validate the request params
+	creditLimit, validationErrs := validateUpdateAccountRequest(req)
+	if validationErrs != nil {
+		logger.Log.Error("Validation failed for UpdateAccount request", zap.String("validation_errors", validationErrs.Error()))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title: writer.ErrTilteValidationFailed,
+				Code:  writer.ErrCodeInvalidRequest,
+			},
+			validationErrs.FieldErrors()...,
+		)
+		return
+	}
+
+	// Update the account
+	dbResp, err := h.DataRepo.UpdateAccount(
+		r.Context(),
+		repository.UpdateAccountReqParams{
+			AccountID:   accountID,
+			CreditLimit: &creditLimit,
+		},
+	)
+	if err != nil {
+		logger.Log.Error("Database call failed for UpdateAccount request", zap.Error(err))
+		// Return 404 error if data not found
+		if errors.Is(err, sql.ErrNoRows) {
+			writer.WriteJSONError(
+				w,
+				http.StatusNotFound,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleDataNotFound,
+					Code:   writer.ErrCodeDataNotFound,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		// Return 400 error if the credit limit is not in the account currency
+		if errors.Is(err, repository.ErrCurrencyMismatch) {
+			writer.WriteJSONError(
+				w,
+				http.StatusBadRequest,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleInvalidRequestPayload,
+					Code:   writer.ErrCodeInvalidRequest,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		// Return 422 error if the credit limit is lower than the credit in use
+		if errors.Is(err, repository.ErrCreditLimitInUse) {
+			writer.WriteJSONError(
+				w,
+				http.StatusUnprocessableEntity,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleCreditLimit,
+					Code:   writer.ErrCodeCreditLimit,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		// Return 500 internal server error for other errors
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Send success response
+	resp := newAccountResponse(dbResp)
+	if err := writer.WriteJSON(w, http.StatusOK, resp); err != nil {
+		logger.Log.Error("Error writting success response for UpdateAccount request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+}
+
+// validateUpdateAccountRequest validates the request object for UpdateAccount API handler
+// and returns the credit limit in minor units.
+func validateUpdateAccountRequest(req UpdateAccountReqParams) (repository.Money, *validator.ValidationErrors) {
+	errors := validator.NewValidationErrors()
+
+	var creditLimit repository.Money
+	if req.CreditLimit == nil {
+		errors.Add("body", "credit_limit must be provided.")
+	} else {
+		creditLimit = parseCreditLimit(errors, *req.CreditLimit)
+	}
+
+	if len(errors.Errors) > 0 {
+		return repository.Money{}, errors
+	}
+
+	return creditLimit, nil
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/decoder"
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/validator"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"go.uber.org/zap"
+)
+
+// UpdateAccountStatus handles the status change of an account. Active accounts can be blocked or closed,
+// blocked accounts can be reactivated or closed, and closed accounts can't be changed anymore.
+func (h *accountsHandler) UpdateAccountStatus(w http.ResponseWriter, r *http.Request) {
+	// path : "app/v1/accounts/{id}/status"
+	path := r.URL.Path
+	parts := strings.Split(path, "/")
+	accountIDStr := parts[4]
+
+	// Get accountID from request URL
+	accountID, err := strconv.Atoi(accountIDStr)
+	if err != nil {
+		logger.Log.Error("Failed to get query param from request URL", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title:  "Invalid Account ID",
+				Code:   writer.ErrCodeInvalidRequest,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Decode the request params
+	var req UpdateAccountStatusReqParams
+	if err := decoder.DecodeJSON(w, r, &req); err != nil {
+		logger.Log.Error("Failed to decode HTTP request payload", zap.Error(err))
+		statusCode, errDesc := decoder.ErrorResponse(err)
+		writer.WriteJSONError(w, statusCode, errDesc)
+		return
+	}
+
+	// Validate the request params
+	if validationErrs := validateUpdateAccountStatusRequest(req); validationErrs != nil {
+		logger.Log.Error("Validation failed for UpdateAccountStatus request", zap.String("validation_errors", validationErrs.Error()))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title: writer.ErrTilteValidationFailed,
+				Code:  writer.ErrCodeInvalidRequest,
+			},
+			validationErrs.FieldErrors()...,
+		)
+		return
+	}
+
+	// Change the account status
+	dbResp, err := h.DataRepo.UpdateAccountStatus(
+		r.Context(),
+		repository.UpdateAccountStatusReqParams{
+			AccountID: accountID,
+			Status:    req.Status,
+			Reason:    req.Reason,
+		},
+	)
+	if err != nil {
+		logger.Log.Error("Database call failed for UpdateAccountStatus request", zap.Error(err))
+		// Return 404 error if data not found
+		if errors.Is(err, sql.ErrNoRows) {
+			writer.WriteJSONError(
+				w,
+				http.StatusNotFound,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleDataNotFound,
+					Code:   writer.ErrCodeDataNotFound,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		// Return 409 error if the account status can't be changed to the requested one
+		if errors.Is(err, repository.ErrAccountStatusTransition) {
+			writer.WriteJSONError(
+				w,
+				http.StatusConflict,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleStatusTransition,
+					Code:   writer.ErrCodeStatusTransition,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		// Return 500 internal server error for other errors
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Send success response
+	resp := newAccountResponse(dbResp)
+	if err := writer.WriteJSON(w, http.StatusOK, resp); err != nil {
+		logger.Log.Error("Error writting success response for UpdateAccountStatus request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+}
+
+// validateUpdateAccountStatusRequest validates the request object for UpdateAccountStatus API handler.
+func validateUpdateAccountStatusRequest(req UpdateAccountStatusReqParams) *validator.ValidationErrors {
+	errors := validator.NewValidationErrors()
+
+	validator.Field(errors, "status", req.Status, validator.Enum(repository.AccountStatusActive, repository.AccountStatusBlocked, repository.AccountStatusClosed))
+	validator.Field(errors, "reason", req.Reason, validator.Required[string](), validator.Length(1, 255))
+
+	if len(errors.Errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	updateAccountStatusEndpoint = "/app/v1/accounts/%d/status"
+)
+
+// testUpdateAccountStatusSuite is a test suite object to test UpdateAccountStatus API handler.
+type testUpdateAccountStatusSuite struct {
+	suite.Suite
+
+	dataRepo        *mocks.DataRepo
+	router          *chi.Mux
+	accountsHandler AccountsHandler
+	recorder        *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testUpdateAccountStatusSuite.
+func (s *testUpdateAccountStatusSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.accountsHandler = NewAccountsHandler(s.dataRepo)
+
+	s.router = chi.NewRouter()
+	s.router.Patch("/app/v1/accounts/{id}/status", s.accountsHandler.UpdateAccountStatus)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestUpdateAccountStatusSuite is the custom test suite runner for UpdateAccountStatus API handler.
+func TestUpdateAccountStatusSuite(t *testing.T) {
+	suite.Run(t, new(testUpdateAccountStatusSuite))
+}
+
+// @Success testcase - statusCode (200)
+func (s *testUpdateAccountStatusSuite) TestUpdateAccountStatusSuccess() {
+	accountID := 1
+	t := time.Now()
+	s.dataRepo.Mock.On("UpdateAccountStatus", mock.Anything, repository.UpdateAccountStatusReqParams{
+		AccountID: accountID,
+		Status:    repository.AccountStatusBlocked,
+		Reason:    "Suspected fraud",
+	}).Return(&repository.AccountResponse{
+		AccountID:      accountID,
+		DocumentNumber: "12345678900",
+		Currency:       "BRL",
+		Status:         repository.AccountStatusBlocked,
+		CreatedAt:      t,
+		UpdatedAt:      t,
+	}, nil)
+
+	reqBody := `{"status": "blocked", "reason": "Suspected fraud"}`
+	req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf(updateAccountStatusEndpoint, accountID), strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"status":"blocked"`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testUpdateAccountStatusSuite) TestUpdateAccountStatusInvalidAccountID() {
+	reqBody := `{"status": "blocked", "reason": "Suspected fraud"}`
+	req := httptest.NewRequest(http.MethodPatch, "/app/v1/accounts/abc/status", strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testUpdateAccountStatusSuite) TestUpdateAccountStatusInvalidRequest() {
+	reqBody := `{"status": "frozen"}`
+	req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf(updateAccountStatusEndpoint, 1), strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"reason","message":"This field is required."}`)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"status","message":"Must be one of active, blocked, closed."}`)
+}
+
+// @Failed testcase - statusCode (404)
+func (s *testUpdateAccountStatusSuite) TestUpdateAccountStatusNotFound() {
+	s.dataRepo.Mock.On("UpdateAccountStatus", mock.Anything, mock.Anything).
+		Return(nil, sql.ErrNoRows)
+
+	reqBody := `{"status": "closed", "reason": "Customer request"}`
+	req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf(updateAccountStatusEndpoint, 1), strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusNotFound, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (409)
+func (s *testUpdateAccountStatusSuite) TestUpdateAccountStatusTransition() {
+	s.dataRepo.Mock.On("UpdateAccountStatus", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("failed to complete the db transaction: %w", repository.ErrAccountStatusTransition))
+
+	reqBody := `{"status": "active", "reason": "Customer request"}`
+	req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf(updateAccountStatusEndpoint, 1), strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusConflict, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"code":"invalid_status_transition"`)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testUpdateAccountStatusSuite) TestUpdateAccountStatusInternalServerError() {
+	s.dataRepo.Mock.On("UpdateAccountStatus", mock.Anything, mock.Anything).
+		Return(nil, errors.New("something went wrong"))
+
+	reqBody := `{"status": "closed", "reason": "Customer request"}`
+	req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf(updateAccountStatusEndpoint, 1), strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
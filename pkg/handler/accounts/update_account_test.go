@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	updateAccountEndpoint = "/app/v1/accounts/%d"
+)
+
+// testUpdateAccountSuite is a test suite object to test UpdateAccount API handler.
+type testUpdateAccountSuite struct {
+	suite.Suite
+
+	dataRepo        *mocks.DataRepo
+	router          *chi.Mux
+	accountsHandler AccountsHandler
+	recorder        *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testUpdateAccountSuite.
+func (s *testUpdateAccountSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.accountsHandler = NewAccountsHandler(s.dataRepo)
+
+	s.router = chi.NewRouter()
+	s.router.Patch("/app/v1/accounts/{id}", s.accountsHandler.UpdateAccount)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestUpdateAccountSuite is the custom test suite runner for UpdateAccount API handler.
+func TestUpdateAccountSuite(t *testing.T) {
+	suite.Run(t, new(testUpdateAccountSuite))
+}
+
+// @Success testcase - statusCode (200)
+func (s *testUpdateAccountSuite) TestUpdateAccountSuccess() {
+	accountID := 1
+	creditLimit := repository.Money{Amount: 150000, Currency: "BRL"}
+	t := time.Now()
+	s.dataRepo.Mock.On("UpdateAccount", mock.Anything, repository.UpdateAccountReqParams{
+		AccountID:   accountID,
+		CreditLimit: &creditLimit,
+	}).Return(&repository.AccountResponse{
+		AccountID:            accountID,
+		DocumentNumber:       "12345678900",
+		Currency:             "BRL",
+		CreditLimit:          creditLimit.Amount,
+		AvailableCreditLimit: 120000,
+		CreatedAt:            t,
+		UpdatedAt:            t,
+	}, nil)
+
+	reqBody := `{"credit_limit": {"value": "1500.00", "currency": "BRL"}}`
+	req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf(updateAccountEndpoint, accountID), strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"credit_limit":{"value":"1500.00","currency":"BRL"}`)
+	s.Contains(s.recorder.Body.String(), `"available_credit_limit":{"value":"1200.00","currency":"BRL"}`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testUpdateAccountSuite) TestUpdateAccountInvalidAccountID() {
+	reqBody := `{"credit_limit": {"value": "1500.00", "currency": "BRL"}}`
+	req := httptest.NewRequest(http.MethodPatch, "/app/v1/accounts/abc", strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testUpdateAccountSuite) TestUpdateAccountEmptyBody() {
+	req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf(updateAccountEndpoint, 1), strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testUpdateAccountSuite) TestUpdateAccountNegativeCreditLimit() {
+	reqBody := `{"credit_limit": {"value": "-10.00", "currency": "BRL"}}`
+	req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf(updateAccountEndpoint, 1), strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"credit_limit.value","message":"Must be at least 0."}`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testUpdateAccountSuite) TestUpdateAccountCreditLimitTooManyDecimals() {
+	reqBody := `{"credit_limit": {"value": "1500.001", "currency": "BRL"}}`
+	req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf(updateAccountEndpoint, 1), strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"credit_limit.value","message":"Must have at most 2 decimal places."}`)
+	s.dataRepo.AssertNotCalled(s.T(), "UpdateAccount", mock.Anything, mock.Anything)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testUpdateAccountSuite) TestUpdateAccountCurrencyMismatch() {
+	s.dataRepo.Mock.On("UpdateAccount", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrCurrencyMismatch)
+
+	reqBody := `{"credit_limit": {"value": "1500.00", "currency": "USD"}}`
+	req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf(updateAccountEndpoint, 1), strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (422)
+func (s *testUpdateAccountSuite) TestUpdateAccountCreditLimitInUse() {
+	s.dataRepo.Mock.On("UpdateAccount", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrCreditLimitInUse)
+
+	reqBody := `{"credit_limit": {"value": "100.00", "currency": "BRL"}}`
+	req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf(updateAccountEndpoint, 1), strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusUnprocessableEntity, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (404)
+func (s *testUpdateAccountSuite) TestUpdateAccountNotFound() {
+	s.dataRepo.Mock.On("UpdateAccount", mock.Anything, mock.Anything).
+		Return(nil, sql.ErrNoRows)
+
+	reqBody := `{"credit_limit": {"value": "1500.00", "currency": "BRL"}}`
+	req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf(updateAccountEndpoint, 1), strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusNotFound, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testUpdateAccountSuite) TestUpdateAccountInternalServerError() {
+	s.dataRepo.Mock.On("UpdateAccount", mock.Anything, mock.Anything).
+		Return(nil, errors.New("something went wrong"))
+
+	reqBody := `{"credit_limit": {"value": "1500.00", "currency": "BRL"}}`
+	req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf(updateAccountEndpoint, 1), strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
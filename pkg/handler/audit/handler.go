@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+)
+
+// AuditHandler is an interface providing methods for audit log-related API requests.
+type AuditHandler interface {
+	ListAuditEntries(w http.ResponseWriter, r *http.Request)
+}
+
+// auditHandler object.
+type auditHandler struct {
+	DataRepo repository.DataRepo
+}
+
+// NewAuditHandler initializes and returns a new AuditHandler.
+func NewAuditHandler(dataRepo repository.DataRepo) AuditHandler {
+	return &auditHandler{
+		DataRepo: dataRepo,
+	}
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/validator"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"go.uber.org/zap"
+)
+
+// ListAuditEntries lists the audit log entries of an entity, oldest first.
+// Supported query params are entity (account, transaction, operation_type, transfer or hold) and id, both required, cursor and limit.
+func (h *auditHandler) ListAuditEntries(w http.ResponseWriter, r *http.Request) {
+	// Parse and validate the filters
+	req, validationErrs := parseListAuditEntriesRequest(r)
+	if validationErrs != nil {
+		logger.Log.Error("Validation failed for ListAuditEntries request", zap.String("validation_errors", validationErrs.Error()))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title: writer.ErrTilteValidationFailed,
+				Code:  writer.ErrCodeInvalidRequest,
+			},
+			validationErrs.FieldErrors()...,
+		)
+		return
+	}
+
+	// Get the audit log entries page
+	dbResp, err := h.DataRepo.ListAuditEntries(r.Context(), req)
+	if err != nil {
+		logger.Log.Error("Database call failed for ListAuditEntries request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Send success response
+	resp := AuditEntriesPageResponse{
+		Entries:    make([]AuditEntryResponse, 0, len(dbResp.Entries)),
+		NextCursor: dbResp.NextCursor,
+	}
+	for i := range dbResp.Entries {
+		resp.Entries = append(resp.Entries, newAuditEntryResponse(&dbResp.Entries[i]))
+	}
+	if err := writer.WriteJSON(w, http.StatusOK, resp); err != nil {
+		logger.Log.Error("Error writting success response for ListAuditEntries request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+}
+
+// parseListAuditEntriesRequest parses and validates the query params for ListAuditEntries API handler.
+func parseListAuditEntriesRequest(r *http.Request) (repository.ListAuditEntriesReqParams, *validator.ValidationErrors) {
+	errors := validator.NewValidationErrors()
+	query := r.URL.Query()
+
+	req := repository.ListAuditEntriesReqParams{
+		Entity: query.Get("entity"),
+		Limit:  defaultPageLimit,
+	}
+
+	validator.Field(
+		errors,
+		"entity",
+		req.Entity,
+		validator.Required[string](),
+		validator.Enum(repository.AuditEntityAccount, repository.AuditEntityTransaction, repository.AuditEntityOperationType, repository.AuditEntityTransfer, repository.AuditEntityHold),
+	)
+
+	entityID, err := strconv.Atoi(query.Get("id"))
+	if err != nil || entityID <= 0 {
+		errors.Add("id", "ID must be a positive integer.")
+	} else {
+		req.EntityID = entityID
+	}
+
+	if v := query.Get("cursor"); v != "" {
+		cursor, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || cursor < 0 {
+			errors.Add("cursor", "Cursor must be a non-negative integer.")
+		} else {
+			req.Cursor = cursor
+		}
+	}
+
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > maxPageLimit {
+			errors.Add("limit", "Limit must be between 1 and 1000.")
+		} else {
+			req.Limit = limit
+		}
+	}
+
+	if len(errors.Errors) > 0 {
+		return req, errors
+	}
+
+	return req, nil
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// testListAuditEntriesSuite is a test suite object to test ListAuditEntries API handler.
+type testListAuditEntriesSuite struct {
+	suite.Suite
+
+	dataRepo     *mocks.DataRepo
+	router       *chi.Mux
+	auditHandler AuditHandler
+	recorder     *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testListAuditEntriesSuite.
+func (s *testListAuditEntriesSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.auditHandler = NewAuditHandler(s.dataRepo)
+
+	s.router = chi.NewRouter()
+	s.router.Get("/app/v1/audit", s.auditHandler.ListAuditEntries)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestListAuditEntriesSuite is the custom test suite runner for ListAuditEntries API handler.
+func TestListAuditEntriesSuite(t *testing.T) {
+	suite.Run(t, new(testListAuditEntriesSuite))
+}
+
+// @Success testcase - statusCode (200)
+func (s *testListAuditEntriesSuite) TestListAuditEntriesSuccess() {
+	t := time.Date(2025, 2, 10, 10, 0, 0, 0, time.UTC)
+	nextCursor := int64(7)
+
+	s.dataRepo.Mock.On("ListAuditEntries", mock.Anything, repository.ListAuditEntriesReqParams{
+		Entity:   repository.AuditEntityAccount,
+		EntityID: 1,
+		Cursor:   5,
+		Limit:    2,
+	}).Return(&repository.AuditEntriesPage{
+		Entries: []repository.AuditEntryResponse{
+			{AuditID: 6, Entity: repository.AuditEntityAccount, EntityID: 1, Action: repository.AuditActionCreated, Actor: "anonymous", RequestID: "req-1", After: []byte(`{"account_id": 1, "status": "active"}`), CreatedAt: t},
+			{AuditID: 7, Entity: repository.AuditEntityAccount, EntityID: 1, Action: repository.AuditActionStatusChanged, Actor: "ops@example.com", RequestID: "req-2", Before: []byte(`{"account_id": 1, "status": "active"}`), After: []byte(`{"account_id": 1, "status": "blocked"}`), CreatedAt: t},
+		},
+		NextCursor: &nextCursor,
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/audit?entity=account&id=1&cursor=5&limit=2", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.JSONEq(`{
+		"entries": [
+			{"audit_id": 6, "entity": "account", "entity_id": 1, "action": "created", "actor": "anonymous", "request_id": "req-1",
+				"before": null, "after": {"account_id": 1, "status": "active"}, "created_at": "2025-02-10T10:00:00Z"},
+			{"audit_id": 7, "entity": "account", "entity_id": 1, "action": "status_changed", "actor": "ops@example.com", "request_id": "req-2",
+				"before": {"account_id": 1, "status": "active"}, "after": {"account_id": 1, "status": "blocked"}, "created_at": "2025-02-10T10:00:00Z"}
+		],
+		"next_cursor": 7
+	}`, s.recorder.Body.String())
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testListAuditEntriesSuite) TestListAuditEntriesMissingFilters() {
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/audit", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"entity","message":"This field is required."}`)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"id","message":"ID must be a positive integer."}`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testListAuditEntriesSuite) TestListAuditEntriesInvalidRequest() {
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/audit?entity=installment&id=1&limit=5000", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"entity","message":"Must be one of account, transaction, operation_type, transfer, hold."}`)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"limit","message":"Limit must be between 1 and 1000."}`)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testListAuditEntriesSuite) TestListAuditEntriesInternalServerError() {
+	s.dataRepo.Mock.On("ListAuditEntries", mock.Anything, mock.Anything).
+		Return(nil, errors.New("something went wrong"))
+
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/audit?entity=transaction&id=1", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
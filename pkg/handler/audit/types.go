@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 1000
+)
+
+// AuditEntryResponse is the response object, which holds an audit log entry.
+// Before and After are the entity before and after the change, Before is null for a created entity.
+type AuditEntryResponse struct {
+	AuditID   int64           `json:"audit_id"`
+	Entity    string          `json:"entity"`
+	EntityID  int             `json:"entity_id"`
+	Action    string          `json:"action"`
+	Actor     string          `json:"actor"`
+	RequestID string          `json:"request_id,omitempty"`
+	Before    json.RawMessage `json:"before"`
+	After     json.RawMessage `json:"after"`
+	CreatedAt string          `json:"created_at"`
+}
+
+// AuditEntriesPageResponse is the response object, which holds a page of audit log entries.
+type AuditEntriesPageResponse struct {
+	Entries    []AuditEntryResponse `json:"entries"`
+	NextCursor *int64               `json:"next_cursor"`
+}
+
+// newAuditEntryResponse converts the audit log entry from database to the API response object.
+func newAuditEntryResponse(dbResp *repository.AuditEntryResponse) AuditEntryResponse {
+	return AuditEntryResponse{
+		AuditID:   dbResp.AuditID,
+		Entity:    dbResp.Entity,
+		EntityID:  dbResp.EntityID,
+		Action:    dbResp.Action,
+		Actor:     dbResp.Actor,
+		RequestID: dbResp.RequestID,
+		Before:    dbResp.Before,
+		After:     dbResp.After,
+		CreatedAt: dbResp.CreatedAt.Format(time.RFC3339),
+	}
+}
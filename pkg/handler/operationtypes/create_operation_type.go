@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/decoder"
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/validator"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"go.uber.org/zap"
+)
+
+// CreateOperationType handles the creation of a new operation type.
+func (h *operationTypesHandler) CreateOperationType(w http.ResponseWriter, r *http.Request) {
+	// Decode the request params
+	var req CreateOperationTypeReqParams
+	if err := decoder.DecodeJSON(w, r, &req); err != nil {
+		logger.Log.Error("Failed to decode HTTP request payload", zap.Error(err))
+		statusCode, errDesc := decoder.ErrorResponse(err)
+		writer.WriteJSONError(w, statusCode, errDesc)
+		return
+	}
+
+	// Validate the request params
+	if validationErrs := validateCreateOperationTypeRequest(req); validationErrs != nil {
+		logger.Log.Error("Validation failed for CreateOperationType request", zap.String("validation_errors", validationErrs.Error()))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title: writer.ErrTilteValidationFailed,
+				Code:  writer.ErrCodeInvalidRequest,
+			},
+			validationErrs.FieldErrors()...,
+		)
+		return
+	}
+
+	// New operation types are active unless stated otherwise
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	// Create new operation type
+	dbResp, err := h.DataRepo.CreateOperationType(
+		r.Context(),
+		repository.CreateOperationTypeReqParams{
+			Description:        req.Description,
+			Sign:               req.Sign,
+			IsActive:           isActive,
+			AllowsInstallments: req.AllowsInstallments,
+		},
+	)
+	if err != nil {
+		logger.Log.Error("Database call failed for CreateOperationType request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Send success response
+	resp := newOperationTypeResponse(dbResp)
+	w.Header().Set("Location", operationTypeLocation(resp.OperationTypeID))
+	if err := writer.WriteJSON(w, http.StatusCreated, resp); err != nil {
+		logger.Log.Error("Error writting success response for CreateOperationType request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+}
+
+// validateCreateOperationTypeRequest validates the request object for CreateOperationType API handler.
+func validateCreateOperationTypeRequest(req CreateOperationTypeReqParams) *validator.ValidationErrors {
+	errors := validator.NewValidationErrors()
+
+	validateDescription(errors, req.Description)
+	validateSign(errors, req.Sign)
+
+	if len(errors.Errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// validateDescription validates the description of an operation type.
+func validateDescription(errors *validator.ValidationErrors, description string) {
+	validator.Field(errors, "description", description, validator.Length(3, 255))
+}
+
+// validateSign validates the sign of an operation type.
+func validateSign(errors *validator.ValidationErrors, sign int) {
+	validator.Field(errors, "sign", sign, validator.Enum(-1, 1))
+}
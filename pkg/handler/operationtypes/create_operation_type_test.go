@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// testCreateOperationTypeSuite is a test suite object to test CreateOperationType API handler.
+type testCreateOperationTypeSuite struct {
+	suite.Suite
+
+	dataRepo              *mocks.DataRepo
+	router                *chi.Mux
+	operationTypesHandler OperationTypesHandler
+	recorder              *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testCreateOperationTypeSuite.
+func (s *testCreateOperationTypeSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.operationTypesHandler = NewOperationTypesHandler(s.dataRepo)
+
+	s.router = chi.NewRouter()
+	s.router.Post("/app/v1/operation-types", s.operationTypesHandler.CreateOperationType)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestCreateOperationTypeSuite is the custom test suite runner for CreateOperationType API handler.
+func TestCreateOperationTypeSuite(t *testing.T) {
+	suite.Run(t, new(testCreateOperationTypeSuite))
+}
+
+// @Success testcase - statusCode (201)
+func (s *testCreateOperationTypeSuite) TestCreateOperationTypeSuccess() {
+	t := time.Now()
+	s.dataRepo.Mock.On("CreateOperationType", mock.Anything, repository.CreateOperationTypeReqParams{
+		Description: "Refund",
+		Sign:        1,
+		IsActive:    true,
+	}).Return(&repository.OperationTypeResponse{
+		OperationTypeID: 5,
+		Description:     "Refund",
+		Sign:            1,
+		IsActive:        true,
+		CreatedAt:       t,
+		UpdatedAt:       t,
+	}, nil)
+
+	reqBody := `{"description": "Refund", "sign": 1}`
+	req := httptest.NewRequest(http.MethodPost, operationTypesEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusCreated, s.recorder.Code)
+	s.Equal("/app/v1/operation-types/5", s.recorder.Header().Get("Location"))
+}
+
+// @Success testcase - statusCode (201)
+func (s *testCreateOperationTypeSuite) TestCreateOperationTypeAllowingInstallments() {
+	t := time.Now()
+	s.dataRepo.Mock.On("CreateOperationType", mock.Anything, repository.CreateOperationTypeReqParams{
+		Description:        "Purchase With Installments",
+		Sign:               -1,
+		IsActive:           true,
+		AllowsInstallments: true,
+	}).Return(&repository.OperationTypeResponse{
+		OperationTypeID:    5,
+		Description:        "Purchase With Installments",
+		Sign:               -1,
+		IsActive:           true,
+		AllowsInstallments: true,
+		CreatedAt:          t,
+		UpdatedAt:          t,
+	}, nil)
+
+	reqBody := `{"description": "Purchase With Installments", "sign": -1, "allows_installments": true}`
+	req := httptest.NewRequest(http.MethodPost, operationTypesEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusCreated, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"allows_installments":true`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateOperationTypeSuite) TestCreateOperationTypeInvalidRequest() {
+	reqBody := `{"description": "Refund", "sign": 1`
+	req := httptest.NewRequest(http.MethodPost, operationTypesEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateOperationTypeSuite) TestCreateOperationTypeInvalidSign() {
+	reqBody := `{"description": "Refund", "sign": 0}`
+	req := httptest.NewRequest(http.MethodPost, operationTypesEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testCreateOperationTypeSuite) TestCreateOperationTypeInternalServerError() {
+	s.dataRepo.Mock.On("CreateOperationType", mock.Anything, mock.Anything).
+		Return(nil, errors.New("something went wrong"))
+
+	reqBody := `{"description": "Chargeback", "sign": 1, "is_active": false}`
+	req := httptest.NewRequest(http.MethodPost, operationTypesEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"go.uber.org/zap"
+)
+
+// GetOperationTypeByID retrieves an operation type using the Operation type ID.
+func (h *operationTypesHandler) GetOperationTypeByID(w http.ResponseWriter, r *http.Request) {
+	// path : "app/v1/operation-types/{id}"
+	path := r.URL.Path
+	parts := strings.Split(path, "/")
+	operationTypeIDStr := parts[4]
+
+	// Get operationTypeID from request URL
+	operationTypeID, err := strconv.Atoi(operationTypeIDStr)
+	if err != nil {
+		logger.Log.Error("Failed to get query param from request URL", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title:  "Invalid Operation Type ID",
+				Code:   writer.ErrCodeInvalidRequest,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Get the operation type using operation type ID
+	dbResp, err := h.DataRepo.GetOperationTypeByID(r.Context(), operationTypeID)
+	if err != nil {
+		logger.Log.Error("Database call failed for GetOperationTypeByID request", zap.Error(err))
+		// Return 404 error if data not found
+		if errors.Is(err, sql.ErrNoRows) {
+			writer.WriteJSONError(
+				w,
+				http.StatusNotFound,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleDataNotFound,
+					Code:   writer.ErrCodeDataNotFound,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		// Return 500 internal server error for other errors
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Send success response
+	resp := newOperationTypeResponse(dbResp)
+	if err := writer.WriteJSON(w, http.StatusOK, resp); err != nil {
+		logger.Log.Error("Error writting success response for GetOperationTypeByID request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+}
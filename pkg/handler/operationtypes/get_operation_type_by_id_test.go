@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	getOperationTypeByIDEndpoint = "/app/v1/operation-types/5"
+)
+
+// testGetOperationTypeByIDSuite is a test suite object to test GetOperationTypeByID API handler.
+type testGetOperationTypeByIDSuite struct {
+	suite.Suite
+
+	dataRepo              *mocks.DataRepo
+	router                *chi.Mux
+	operationTypesHandler OperationTypesHandler
+	recorder              *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testGetOperationTypeByIDSuite.
+func (s *testGetOperationTypeByIDSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.operationTypesHandler = NewOperationTypesHandler(s.dataRepo)
+
+	s.router = chi.NewRouter()
+	s.router.Get("/app/v1/operation-types/{id}", s.operationTypesHandler.GetOperationTypeByID)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestGetOperationTypeByIDSuite is the custom test suite runner for GetOperationTypeByID API handler.
+func TestGetOperationTypeByIDSuite(t *testing.T) {
+	suite.Run(t, new(testGetOperationTypeByIDSuite))
+}
+
+// @Success testcase - statusCode (200)
+func (s *testGetOperationTypeByIDSuite) TestGetOperationTypeByIDSuccess() {
+	t := time.Now()
+	s.dataRepo.Mock.On("GetOperationTypeByID", mock.Anything, 5).
+		Return(&repository.OperationTypeResponse{
+			OperationTypeID: 5,
+			Description:     "Refund",
+			Sign:            1,
+			IsActive:        true,
+			CreatedAt:       t,
+			UpdatedAt:       t,
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, getOperationTypeByIDEndpoint, nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"operation_type_id":5`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testGetOperationTypeByIDSuite) TestGetOperationTypeByIDInvalidOperationTypeID() {
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/operation-types/abc", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (404)
+func (s *testGetOperationTypeByIDSuite) TestGetOperationTypeByIDNotFound() {
+	s.dataRepo.Mock.On("GetOperationTypeByID", mock.Anything, 5).
+		Return(nil, sql.ErrNoRows)
+
+	req := httptest.NewRequest(http.MethodGet, getOperationTypeByIDEndpoint, nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusNotFound, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testGetOperationTypeByIDSuite) TestGetOperationTypeByIDInternalServerError() {
+	s.dataRepo.Mock.On("GetOperationTypeByID", mock.Anything, 5).
+		Return(nil, errors.New("something went wrong"))
+
+	req := httptest.NewRequest(http.MethodGet, getOperationTypeByIDEndpoint, nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
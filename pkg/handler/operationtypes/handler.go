@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+)
+
+// OperationTypesHandler is an interface providing methods for operation types-related API requests.
+type OperationTypesHandler interface {
+	ListOperationTypes(w http.ResponseWriter, r *http.Request)
+	GetOperationTypeByID(w http.ResponseWriter, r *http.Request)
+	CreateOperationType(w http.ResponseWriter, r *http.Request)
+	UpdateOperationType(w http.ResponseWriter, r *http.Request)
+}
+
+// operationTypesHandler object.
+type operationTypesHandler struct {
+	DataRepo repository.DataRepo
+}
+
+// NewOperationTypesHandler initializes and returns a new OperationTypesHandler.
+func NewOperationTypesHandler(dataRepo repository.DataRepo) OperationTypesHandler {
+	return &operationTypesHandler{
+		DataRepo: dataRepo,
+	}
+}
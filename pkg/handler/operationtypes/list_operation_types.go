@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"go.uber.org/zap"
+)
+
+// ListOperationTypes lists all the operation types.
+func (h *operationTypesHandler) ListOperationTypes(w http.ResponseWriter, r *http.Request) {
+	// Get all the operation types
+	dbResp, err := h.DataRepo.ListOperationTypes(r.Context())
+	if err != nil {
+		logger.Log.Error("Database call failed for ListOperationTypes request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Send success response
+	resp := OperationTypesResponse{
+		OperationTypes: make([]OperationTypeResponse, 0, len(dbResp)),
+	}
+	for i := range dbResp {
+		resp.OperationTypes = append(resp.OperationTypes, newOperationTypeResponse(&dbResp[i]))
+	}
+	if err := writer.WriteJSON(w, http.StatusOK, resp); err != nil {
+		logger.Log.Error("Error writting success response for ListOperationTypes request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+}
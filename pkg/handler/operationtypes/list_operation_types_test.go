@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	operationTypesEndpoint = "/app/v1/operation-types"
+)
+
+// testListOperationTypesSuite is a test suite object to test ListOperationTypes API handler.
+type testListOperationTypesSuite struct {
+	suite.Suite
+
+	dataRepo              *mocks.DataRepo
+	router                *chi.Mux
+	operationTypesHandler OperationTypesHandler
+	recorder              *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testListOperationTypesSuite.
+func (s *testListOperationTypesSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.operationTypesHandler = NewOperationTypesHandler(s.dataRepo)
+
+	s.router = chi.NewRouter()
+	s.router.Get("/app/v1/operation-types", s.operationTypesHandler.ListOperationTypes)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestListOperationTypesSuite is the custom test suite runner for ListOperationTypes API handler.
+func TestListOperationTypesSuite(t *testing.T) {
+	suite.Run(t, new(testListOperationTypesSuite))
+}
+
+// @Success testcase - statusCode (200)
+func (s *testListOperationTypesSuite) TestListOperationTypesSuccess() {
+	t := time.Now()
+	s.dataRepo.Mock.On("ListOperationTypes", mock.Anything).
+		Return([]repository.OperationTypeResponse{
+			{
+				OperationTypeID: 1,
+				Description:     "Normal Purchase",
+				Sign:            -1,
+				IsActive:        true,
+				CreatedAt:       t,
+				UpdatedAt:       t,
+			},
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, operationTypesEndpoint, nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"description":"Normal Purchase"`)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testListOperationTypesSuite) TestListOperationTypesInternalServerError() {
+	s.dataRepo.Mock.On("ListOperationTypes", mock.Anything).
+		Return(nil, errors.New("something went wrong"))
+
+	req := httptest.NewRequest(http.MethodGet, operationTypesEndpoint, nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
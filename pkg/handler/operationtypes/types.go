@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+)
+
+// operationTypeLocationFormat is the format of the Location header for a created operation type.
+const operationTypeLocationFormat = "/app/v1/operation-types/%d"
+
+// CreateOperationTypeReqParams is the request object for CreateOperationType API.
+// AllowsInstallments is whether its transactions can be paid in installments.
+type CreateOperationTypeReqParams struct {
+	Description        string `json:"description"`
+	Sign               int    `json:"sign"`
+	IsActive           *bool  `json:"is_active"`
+	AllowsInstallments bool   `json:"allows_installments"`
+}
+
+// UpdateOperationTypeReqParams is the request object for UpdateOperationType API.
+type UpdateOperationTypeReqParams struct {
+	Description        *string `json:"description"`
+	Sign               *int    `json:"sign"`
+	IsActive           *bool   `json:"is_active"`
+	AllowsInstallments *bool   `json:"allows_installments"`
+}
+
+// OperationTypeResponse is the response object, which holds Operation type data.
+type OperationTypeResponse struct {
+	OperationTypeID    int    `json:"operation_type_id"`
+	Description        string `json:"description"`
+	Sign               int    `json:"sign"`
+	IsActive           bool   `json:"is_active"`
+	AllowsInstallments bool   `json:"allows_installments"`
+	SystemKey          string `json:"system_key,omitempty"`
+	CreatedAt          string `json:"created_at"`
+	UpdatedAt          string `json:"updated_at"`
+}
+
+// OperationTypesResponse is the response object for ListOperationTypes API.
+type OperationTypesResponse struct {
+	OperationTypes []OperationTypeResponse `json:"operation_types"`
+}
+
+// newOperationTypeResponse converts the Operation type data from database to the API response object.
+func newOperationTypeResponse(dbResp *repository.OperationTypeResponse) OperationTypeResponse {
+	var systemKey string
+	if dbResp.SystemKey != nil {
+		systemKey = *dbResp.SystemKey
+	}
+
+	return OperationTypeResponse{
+		OperationTypeID:    dbResp.OperationTypeID,
+		Description:        dbResp.Description,
+		Sign:               dbResp.Sign,
+		IsActive:           dbResp.IsActive,
+		AllowsInstallments: dbResp.AllowsInstallments,
+		SystemKey:          systemKey,
+		CreatedAt:          dbResp.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:          dbResp.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// operationTypeLocation returns the URL path of the given operation type.
+func operationTypeLocation(operationTypeID int) string {
+	return fmt.Sprintf(operationTypeLocationFormat, operationTypeID)
+}
@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/decoder"
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/validator"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"go.uber.org/zap"
+)
+
+// UpdateOperationType handles the partial update of an operation type.
+func (h *operationTypesHandler) UpdateOperationType(w http.ResponseWriter, r *http.Request) {
+	// path : "app/v1/operation-types/{id}"
+	path := r.URL.Path
+	parts := strings.Split(path, "/")
+	operationTypeIDStr := parts[4]
+
+	// Get operationTypeID from request URL
+	operationTypeID, err := strconv.Atoi(operationTypeIDStr)
+	if err != nil {
+		logger.Log.Error("Failed to get query param from request URL", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title:  "Invalid Operation Type ID",
+				Code:   writer.ErrCodeInvalidRequest,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Decode the request params
+	var req UpdateOperationTypeReqParams
+	if err := decoder.DecodeJSON(w, r, &req); err != nil {
+		logger.Log.Error("Failed to decode HTTP request payload", zap.Error(err))
+		statusCode, errDesc := decoder.ErrorResponse(err)
+		writer.WriteJSONError(w, statusCode, errDesc)
+		return
+	}
+
+	// Validate the request params
+	if validationErrs := validateUpdateOperationTypeRequest(req); validationErrs != nil {
+		logger.Log.Error("Validation failed for UpdateOperationType request", zap.String("validation_errors", validationErrs.Error()))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title: writer.ErrTilteValidationFailed,
+				Code:  writer.ErrCodeInvalidRequest,
+			},
+			validationErrs.FieldErrors()...,
+		)
+		return
+	}
+
+	// Update the operation type
+	dbResp, err := h.DataRepo.UpdateOperationType(
+		r.Context(),
+		repository.UpdateOperationTypeReqParams{
+			OperationTypeID:    operationTypeID,
+			Description:        req.Description,
+			Sign:               req.Sign,
+			IsActive:           req.IsActive,
+			AllowsInstallments: req.AllowsInstallments,
+		},
+	)
+	if err != nil {
+		logger.Log.Error("Database call failed for UpdateOperationType request", zap.Error(err))
+		// Return 404 error if data not found
+		if errors.Is(err, sql.ErrNoRows) {
+			writer.WriteJSONError(
+				w,
+				http.StatusNotFound,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleDataNotFound,
+					Code:   writer.ErrCodeDataNotFound,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		// Return 409 conflict error if the operation type is reserved for the system
+		if errors.Is(err, repository.ErrSystemOperationType) {
+			writer.WriteJSONError(
+				w,
+				http.StatusConflict,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleSystemOperationType,
+					Code:   writer.ErrCodeSystemOperationType,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		// Return 500 internal server error for other errors
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Send success response
+	resp := newOperationTypeResponse(dbResp)
+	if err := writer.WriteJSON(w, http.StatusOK, resp); err != nil {
+		logger.Log.Error("Error writting success response for UpdateOperationType request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+}
+
+// validateUpdateOperationTypeRequest validates the request object for UpdateOperationType API handler.
+func validateUpdateOperationTypeRequest(req UpdateOperationTypeReqParams) *validator.ValidationErrors {
+	errors := validator.NewValidationErrors()
+
+	if req.Description == nil && req.Sign == nil && req.IsActive == nil && req.AllowsInstallments == nil {
+		errors.Add("body", "At least one of description, sign, is_active or allows_installments must be provided.")
+	}
+
+	if req.Description != nil {
+		validateDescription(errors, *req.Description)
+	}
+
+	if req.Sign != nil {
+		validateSign(errors, *req.Sign)
+	}
+
+	if len(errors.Errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	updateOperationTypeEndpoint = "/app/v1/operation-types/5"
+)
+
+// testUpdateOperationTypeSuite is a test suite object to test UpdateOperationType API handler.
+type testUpdateOperationTypeSuite struct {
+	suite.Suite
+
+	dataRepo              *mocks.DataRepo
+	router                *chi.Mux
+	operationTypesHandler OperationTypesHandler
+	recorder              *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testUpdateOperationTypeSuite.
+func (s *testUpdateOperationTypeSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.operationTypesHandler = NewOperationTypesHandler(s.dataRepo)
+
+	s.router = chi.NewRouter()
+	s.router.Patch("/app/v1/operation-types/{id}", s.operationTypesHandler.UpdateOperationType)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestUpdateOperationTypeSuite is the custom test suite runner for UpdateOperationType API handler.
+func TestUpdateOperationTypeSuite(t *testing.T) {
+	suite.Run(t, new(testUpdateOperationTypeSuite))
+}
+
+// @Success testcase - statusCode (200)
+func (s *testUpdateOperationTypeSuite) TestUpdateOperationTypeSuccess() {
+	t := time.Now()
+	isActive := false
+	s.dataRepo.Mock.On("UpdateOperationType", mock.Anything, repository.UpdateOperationTypeReqParams{
+		OperationTypeID: 5,
+		IsActive:        &isActive,
+	}).Return(&repository.OperationTypeResponse{
+		OperationTypeID: 5,
+		Description:     "Refund",
+		Sign:            1,
+		IsActive:        false,
+		CreatedAt:       t,
+		UpdatedAt:       t,
+	}, nil)
+
+	reqBody := `{"is_active": false}`
+	req := httptest.NewRequest(http.MethodPatch, updateOperationTypeEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"is_active":false`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testUpdateOperationTypeSuite) TestUpdateOperationTypeInvalidID() {
+	reqBody := `{"is_active": false}`
+	req := httptest.NewRequest(http.MethodPatch, "/app/v1/operation-types/abc", strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testUpdateOperationTypeSuite) TestUpdateOperationTypeEmptyRequest() {
+	req := httptest.NewRequest(http.MethodPatch, updateOperationTypeEndpoint, strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (409)
+func (s *testUpdateOperationTypeSuite) TestUpdateOperationTypeSystem() {
+	s.dataRepo.Mock.On("UpdateOperationType", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrSystemOperationType)
+
+	reqBody := `{"description": "Transfer"}`
+	req := httptest.NewRequest(http.MethodPatch, updateOperationTypeEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusConflict, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"code":"system_operation_type"`)
+}
+
+// @Failed testcase - statusCode (404)
+func (s *testUpdateOperationTypeSuite) TestUpdateOperationTypeDataNotFound() {
+	s.dataRepo.Mock.On("UpdateOperationType", mock.Anything, mock.Anything).
+		Return(nil, sql.ErrNoRows)
+
+	reqBody := `{"description": "Refund"}`
+	req := httptest.NewRequest(http.MethodPatch, updateOperationTypeEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusNotFound, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testUpdateOperationTypeSuite) TestUpdateOperationTypeInternalServerError() {
+	s.dataRepo.Mock.On("UpdateOperationType", mock.Anything, mock.Anything).
+		Return(nil, errors.New("something went wrong"))
+
+	reqBody := `{"sign": -1}`
+	req := httptest.NewRequest(http.MethodPatch, updateOperationTypeEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/decoder"
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/validator"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"go.uber.org/zap"
+)
+
+// CaptureHold handles the capture of the whole or part of a hold into a debit transaction,
+// returning the captured hold and the transaction. The part not captured is released.
+func (h *transactionsHandler) CaptureHold(w http.ResponseWriter, r *http.Request) {
+	// path : "app/v1/holds/{id}/capture"
+	path := r.URL.Path
+	parts := strings.Split(path, "/")
+	holdIDStr := parts[4]
+
+	// Get holdID from request URL
+	holdID, err := strconv.Atoi(holdIDStr)
+	if err != nil {
+		logger.Log.Error("Failed to get query param from request URL", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title:  "Invalid Hold ID",
+				Code:   writer.ErrCodeInvalidRequest,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Decode the request params, the whole hold is captured without a body
+	var req CaptureHoldReqParams
+	if r.ContentLength != 0 {
+		if err := decoder.DecodeJSON(w, r, &req); err != nil {
+			logger.Log.Error("Failed to decode HTTP request payload", zap.Error(err))
+			statusCode, errDesc := decoder.ErrorResponse(err)
+			writer.WriteJSONError(w, statusCode, errDesc)
+			return
+		}
+	}
+
+	// Validate the request params
+	captureReq, validationErrs := parseCaptureHoldRequest(holdID, req)
+	if validationErrs != nil {
+		logger.Log.Error("Validation failed for CaptureHold request", zap.String("validation_errors", validationErrs.Error()))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title: writer.ErrTilteValidationFailed,
+				Code:  writer.ErrCodeInvalidRequest,
+			},
+			validationErrs.FieldErrors()...,
+		)
+		return
+	}
+
+	// Capture the hold
+	dbResp, err := h.DataRepo.CaptureHold(r.Context(), captureReq)
+	if err != nil {
+		logger.Log.Error("Database call failed for CaptureHold request", zap.Error(err))
+		// Return 404 error if data not found
+		if errors.Is(err, sql.ErrNoRows) {
+			writer.WriteJSONError(
+				w,
+				http.StatusNotFound,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleDataNotFound,
+					Code:   writer.ErrCodeDataNotFound,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		if errors.Is(err, repository.ErrHoldNotActive) || errors.Is(err, repository.ErrHoldExpired) {
+			statusCode, errDesc := holdStateError(err)
+			writer.WriteJSONError(w, statusCode, errDesc)
+			return
+		}
+
+		if errors.Is(err, repository.ErrAccountBlocked) || errors.Is(err, repository.ErrAccountClosed) {
+			statusCode, errDesc := accountStatusError(err)
+			writer.WriteJSONError(w, statusCode, errDesc)
+			return
+		}
+
+		if errors.Is(err, repository.ErrCurrencyMismatch) ||
+			errors.Is(err, repository.ErrCaptureExceedsHold) ||
+			errors.Is(err, repository.ErrOperationTypeInactive) ||
+			errors.Is(err, repository.ErrSystemOperationType) ||
+			errors.Is(err, repository.ErrHoldNotDebit) ||
+			errors.Is(err, repository.ErrHoldInstallmentsNotAllowed) {
+			writer.WriteJSONError(
+				w,
+				http.StatusBadRequest,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleInvalidRequestPayload,
+					Code:   writer.ErrCodeInvalidRequest,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Send success response
+	resp := HoldCaptureResponse{
+		Hold:        newHoldResponse(&dbResp.Hold),
+		Transaction: newTransactionResponse(&dbResp.Transaction),
+	}
+	w.Header().Set("Location", transactionLocation(resp.Transaction.TransactionID))
+	if err := writer.WriteJSON(w, http.StatusCreated, resp); err != nil {
+		logger.Log.Error("Error writting success response for CaptureHold request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+}
+
+// parseCaptureHoldRequest validates the request object for CaptureHold API handler
+// and converts it to the repository request.
+func parseCaptureHoldRequest(holdID int, req CaptureHoldReqParams) (repository.CaptureHoldReqParams, *validator.ValidationErrors) {
+	errors := validator.NewValidationErrors()
+
+	captureReq := repository.CaptureHoldReqParams{
+		HoldID: holdID,
+	}
+
+	if req.Amount != nil {
+		amount := ParseAmount(errors, "amount", *req.Amount, validator.Positive[int64]())
+		captureReq.Amount = &amount
+	}
+
+	if len(errors.Errors) > 0 {
+		return captureReq, errors
+	}
+
+	return captureReq, nil
+}
@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	captureHoldEndpoint = "/app/v1/holds/%d/capture"
+)
+
+// testCaptureHoldSuite is a test suite object to test CaptureHold API handler.
+type testCaptureHoldSuite struct {
+	suite.Suite
+
+	dataRepo   *mocks.DataRepo
+	router     *chi.Mux
+	trxHandler TransactionsHandler
+	recorder   *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testCaptureHoldSuite.
+func (s *testCaptureHoldSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.trxHandler = NewTransactionsHandler(s.dataRepo, testConfig)
+
+	s.router = chi.NewRouter()
+	s.router.Post("/app/v1/holds/{id}/capture", s.trxHandler.CaptureHold)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestCaptureHoldSuite is the custom test suite runner for CaptureHold API handler.
+func TestCaptureHoldSuite(t *testing.T) {
+	suite.Run(t, new(testCaptureHoldSuite))
+}
+
+// postCapture sends the CaptureHold request of hold 5 with the given body, without a body when it's empty.
+func (s *testCaptureHoldSuite) postCapture(reqBody string) {
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf(captureHoldEndpoint, 5), nil)
+	if reqBody != "" {
+		req = httptest.NewRequest(http.MethodPost, fmt.Sprintf(captureHoldEndpoint, 5), strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	s.router.ServeHTTP(s.recorder, req)
+}
+
+// capturedHold returns the response of hold 5 captured for the given amount.
+func capturedHold(amount int64) *repository.HoldCaptureResponse {
+	hold := testHold()
+	transactionID := 30
+	hold.Status = repository.HoldStatusCaptured
+	hold.CapturedAmount = amount
+	hold.TransactionID = &transactionID
+
+	t := time.Date(2025, 2, 18, 9, 0, 0, 0, time.UTC)
+	return &repository.HoldCaptureResponse{
+		Hold: *hold,
+		Transaction: repository.TransactionResponse{
+			TransactionID:   transactionID,
+			AccountID:       1,
+			OperationTypeID: 1,
+			Amount:          -amount,
+			Currency:        "BRL",
+			Balance:         -amount,
+			Status:          repository.TransactionStatusPosted,
+			EventDate:       t,
+			CreatedAt:       t,
+			UpdatedAt:       t,
+		},
+	}
+}
+
+// @Success testcase - statusCode (201)
+func (s *testCaptureHoldSuite) TestCaptureHoldFully() {
+	s.dataRepo.Mock.On("CaptureHold", mock.Anything, repository.CaptureHoldReqParams{HoldID: 5}).
+		Return(capturedHold(5000), nil)
+
+	s.postCapture("")
+	s.Equal(http.StatusCreated, s.recorder.Code)
+	s.Equal("/app/v1/transactions/30", s.recorder.Header().Get("Location"))
+	s.Contains(s.recorder.Body.String(), `"captured_amount":{"value":"50.00","currency":"BRL"}`)
+	s.Contains(s.recorder.Body.String(), `"status":"captured"`)
+	s.Contains(s.recorder.Body.String(), `"transaction_id":30`)
+}
+
+// @Success testcase - statusCode (201)
+func (s *testCaptureHoldSuite) TestCaptureHoldPartially() {
+	s.dataRepo.Mock.On("CaptureHold", mock.Anything, repository.CaptureHoldReqParams{
+		HoldID: 5,
+		Amount: &repository.Money{Amount: 4200, Currency: "BRL"},
+	}).Return(capturedHold(4200), nil)
+
+	s.postCapture(`{"amount": {"value": "42.00", "currency": "BRL"}}`)
+	s.Equal(http.StatusCreated, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"amount":{"value":"-42.00","currency":"BRL"}`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCaptureHoldSuite) TestCaptureHoldInvalidHoldID() {
+	req := httptest.NewRequest(http.MethodPost, "/app/v1/holds/abc/capture", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCaptureHoldSuite) TestCaptureHoldInvalidAmount() {
+	s.postCapture(`{"amount": {"value": "0", "currency": "BRL"}}`)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"amount.value","message":"Must be greater than zero."}`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCaptureHoldSuite) TestCaptureHoldExceedsHold() {
+	s.dataRepo.Mock.On("CaptureHold", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrCaptureExceedsHold)
+
+	s.postCapture(`{"amount": {"value": "50.01", "currency": "BRL"}}`)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCaptureHoldSuite) TestCaptureHoldOperationTypeInactive() {
+	s.dataRepo.Mock.On("CaptureHold", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrOperationTypeInactive)
+
+	s.postCapture("")
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), repository.ErrOperationTypeInactive.Error())
+}
+
+// @Failed testcase - statusCode (404)
+func (s *testCaptureHoldSuite) TestCaptureHoldNotFound() {
+	s.dataRepo.Mock.On("CaptureHold", mock.Anything, mock.Anything).
+		Return(nil, sql.ErrNoRows)
+
+	s.postCapture("")
+	s.Equal(http.StatusNotFound, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (409)
+func (s *testCaptureHoldSuite) TestCaptureHoldNotActive() {
+	s.dataRepo.Mock.On("CaptureHold", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrHoldNotActive)
+
+	s.postCapture("")
+	s.Equal(http.StatusConflict, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"code":"hold_not_active"`)
+}
+
+// @Failed testcase - statusCode (409)
+func (s *testCaptureHoldSuite) TestCaptureHoldExpired() {
+	s.dataRepo.Mock.On("CaptureHold", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrHoldExpired)
+
+	s.postCapture("")
+	s.Equal(http.StatusConflict, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"code":"hold_expired"`)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testCaptureHoldSuite) TestCaptureHoldInternalServerError() {
+	s.dataRepo.Mock.On("CaptureHold", mock.Anything, mock.Anything).
+		Return(nil, errors.New("something went wrong"))
+
+	s.postCapture("")
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
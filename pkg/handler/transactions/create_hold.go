@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/decoder"
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/validator"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"go.uber.org/zap"
+)
+
+// CreateHold handles the creation of a hold, reserving the available credit of an account for a debit
+// until the hold is captured, voided or expires after the configured TTL.
+func (h *transactionsHandler) CreateHold(w http.ResponseWriter, r *http.Request) {
+	// Decode the request params
+	var req CreateHoldReqParams
+	if err := decoder.DecodeJSON(w, r, &req); err != nil {
+		logger.Log.Error("Failed to decode HTTP request payload", zap.Error(err))
+		statusCode, errDesc := decoder.ErrorResponse(err)
+		writer.WriteJSONError(w, statusCode, errDesc)
+		return
+	}
+
+	// Validate the request params
+	holdReq, validationErrs := parseCreateHoldRequest(req, h.Config)
+	if validationErrs != nil {
+		logger.Log.Error("Validation failed for CreateHold request", zap.String("validation_errors", validationErrs.Error()))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title: writer.ErrTilteValidationFailed,
+				Code:  writer.ErrCodeInvalidRequest,
+			},
+			validationErrs.FieldErrors()...,
+		)
+		return
+	}
+
+	// Create the hold
+	dbResp, err := h.DataRepo.CreateHold(r.Context(), holdReq)
+	if err != nil {
+		// Handle errors
+		if errors.Is(err, repository.ErrInsufficientCreditLimit) {
+			logger.Log.Error("Failed to create hold", zap.Error(err))
+			writer.WriteJSONError(
+				w,
+				http.StatusUnprocessableEntity,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleCreditLimit,
+					Code:   writer.ErrCodeCreditLimit,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		if errors.Is(err, repository.ErrAccountBlocked) || errors.Is(err, repository.ErrAccountClosed) {
+			logger.Log.Error("Failed to create hold", zap.Error(err))
+			statusCode, errDesc := accountStatusError(err)
+			writer.WriteJSONError(w, statusCode, errDesc)
+			return
+		}
+
+		if errors.Is(err, repository.ErrAccountIDNotExists) ||
+			errors.Is(err, repository.ErrOperationTypeIDNotExists) ||
+			errors.Is(err, repository.ErrOperationTypeInactive) ||
+			errors.Is(err, repository.ErrSystemOperationType) ||
+			errors.Is(err, repository.ErrCurrencyMismatch) ||
+			errors.Is(err, repository.ErrHoldNotDebit) ||
+			errors.Is(err, repository.ErrHoldInstallmentsNotAllowed) {
+			logger.Log.Error("Failed to create hold", zap.Error(err))
+			writer.WriteJSONError(
+				w,
+				http.StatusBadRequest,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleInvalidRequestPayload,
+					Code:   writer.ErrCodeInvalidRequest,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		logger.Log.Error("Database call failed for CreateHold request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Send success response
+	if err := writer.WriteJSON(w, http.StatusCreated, newHoldResponse(dbResp)); err != nil {
+		logger.Log.Error("Error writting success response for CreateHold request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+}
+
+// holdStateError returns the status code and error description of a hold which can't be captured or voided
+// as it's not active any more or has expired.
+func holdStateError(err error) (int, writer.ErrorDescription) {
+	if errors.Is(err, repository.ErrHoldExpired) {
+		return http.StatusConflict, writer.ErrorDescription{
+			Title:  writer.ErrTitleHoldExpired,
+			Code:   writer.ErrCodeHoldExpired,
+			Detail: err.Error(),
+		}
+	}
+
+	return http.StatusConflict, writer.ErrorDescription{
+		Title:  writer.ErrTitleHoldNotActive,
+		Code:   writer.ErrCodeHoldNotActive,
+		Detail: err.Error(),
+	}
+}
+
+// parseCreateHoldRequest validates the request object for CreateHold API handler
+// and converts it to the repository request, expiring after the configured hold TTL.
+func parseCreateHoldRequest(req CreateHoldReqParams, config Config) (repository.CreateHoldReqParams, *validator.ValidationErrors) {
+	errors := validator.NewValidationErrors()
+
+	holdReq := repository.CreateHoldReqParams{
+		AccountID:       req.AccountID,
+		OperationTypeID: req.OperationTypeID,
+		TTL:             config.HoldTTL,
+	}
+
+	validator.Field(errors, "account_id", req.AccountID, validator.Min(1))
+	validator.Field(errors, "operation_type_id", req.OperationTypeID, validator.Min(1))
+	holdReq.Amount = ParseAmount(errors, "amount", req.Amount, validator.Positive[int64]())
+
+	if len(errors.Errors) > 0 {
+		return holdReq, errors
+	}
+
+	return holdReq, nil
+}
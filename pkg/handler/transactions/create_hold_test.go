@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	createHoldEndpoint = "/app/v1/holds"
+)
+
+// testCreateHoldSuite is a test suite object to test CreateHold API handler.
+type testCreateHoldSuite struct {
+	suite.Suite
+
+	dataRepo   *mocks.DataRepo
+	router     *chi.Mux
+	trxHandler TransactionsHandler
+	recorder   *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testCreateHoldSuite.
+func (s *testCreateHoldSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.trxHandler = NewTransactionsHandler(s.dataRepo, testConfig)
+
+	s.router = chi.NewRouter()
+	s.router.Post(createHoldEndpoint, s.trxHandler.CreateHold)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestCreateHoldSuite is the custom test suite runner for CreateHold API handler.
+func TestCreateHoldSuite(t *testing.T) {
+	suite.Run(t, new(testCreateHoldSuite))
+}
+
+// postHold sends the CreateHold request with the given body.
+func (s *testCreateHoldSuite) postHold(reqBody string) {
+	req := httptest.NewRequest(http.MethodPost, createHoldEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+}
+
+// testHold returns an active hold of 50.00 BRL on account 1.
+func testHold() *repository.HoldResponse {
+	t := time.Date(2025, 2, 17, 9, 0, 0, 0, time.UTC)
+	return &repository.HoldResponse{
+		HoldID:          5,
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          5000,
+		Currency:        "BRL",
+		Status:          repository.HoldStatusActive,
+		ExpiresAt:       t.Add(7 * 24 * time.Hour),
+		CreatedAt:       t,
+		UpdatedAt:       t,
+	}
+}
+
+// @Success testcase - statusCode (201)
+func (s *testCreateHoldSuite) TestCreateHoldSuccess() {
+	// The hold expires after the configured TTL
+	s.dataRepo.Mock.On("CreateHold", mock.Anything, repository.CreateHoldReqParams{
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          repository.Money{Amount: 5000, Currency: "BRL"},
+		TTL:             testConfig.HoldTTL,
+	}).Return(testHold(), nil)
+
+	s.postHold(`{"account_id": 1, "operation_type_id": 1, "amount": {"value": "50.00", "currency": "BRL"}}`)
+	s.Equal(http.StatusCreated, s.recorder.Code)
+	s.JSONEq(`{
+		"hold_id": 5,
+		"account_id": 1,
+		"operation_type_id": 1,
+		"amount": {"value": "50.00", "currency": "BRL"},
+		"captured_amount": {"value": "0.00", "currency": "BRL"},
+		"status": "active",
+		"expires_at": "2025-02-24T09:00:00Z",
+		"created_at": "2025-02-17T09:00:00Z",
+		"updated_at": "2025-02-17T09:00:00Z"
+	}`, s.recorder.Body.String())
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateHoldSuite) TestCreateHoldInvalidFields() {
+	s.postHold(`{"account_id": 0, "operation_type_id": 1, "amount": {"value": "-50.00", "currency": "BRL"}}`)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"account_id","message":"Must be at least 1."}`)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"amount.value","message":"Must be greater than zero."}`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateHoldSuite) TestCreateHoldNotDebit() {
+	s.dataRepo.Mock.On("CreateHold", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrHoldNotDebit)
+
+	s.postHold(`{"account_id": 1, "operation_type_id": 4, "amount": {"value": "50.00", "currency": "BRL"}}`)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), repository.ErrHoldNotDebit.Error())
+}
+
+// @Failed testcase - statusCode (422)
+func (s *testCreateHoldSuite) TestCreateHoldInsufficientCreditLimit() {
+	s.dataRepo.Mock.On("CreateHold", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrInsufficientCreditLimit)
+
+	s.postHold(`{"account_id": 1, "operation_type_id": 1, "amount": {"value": "50.00", "currency": "BRL"}}`)
+	s.Equal(http.StatusUnprocessableEntity, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"code":"insufficient_credit_limit"`)
+}
+
+// @Failed testcase - statusCode (422)
+func (s *testCreateHoldSuite) TestCreateHoldAccountBlocked() {
+	s.dataRepo.Mock.On("CreateHold", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrAccountBlocked)
+
+	s.postHold(`{"account_id": 1, "operation_type_id": 1, "amount": {"value": "50.00", "currency": "BRL"}}`)
+	s.Equal(http.StatusUnprocessableEntity, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"code":"account_blocked"`)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testCreateHoldSuite) TestCreateHoldInternalServerError() {
+	s.dataRepo.Mock.On("CreateHold", mock.Anything, mock.Anything).
+		Return(nil, errors.New("something went wrong"))
+
+	s.postHold(`{"account_id": 1, "operation_type_id": 1, "amount": {"value": "50.00", "currency": "BRL"}}`)
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
@@ -1,11 +1,17 @@
 package handler
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/aswinudhayakumar/account-transactions/internal/decoder"
 	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/validator"
 	"github.com/aswinudhayakumar/account-transactions/internal/writer"
 	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
 	"go.uber.org/zap"
@@ -15,31 +21,95 @@ import (
 func (h *transactionsHandler) CreateTransaction(w http.ResponseWriter, r *http.Request) {
 	// Decode the request params
 	var req CreateTrxReqParams
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	if err := decoder.DecodeJSON(w, r, &req); err != nil {
 		logger.Log.Error("Failed to decode HTTP request payload", zap.Error(err))
+		statusCode, errDesc := decoder.ErrorResponse(err)
+		writer.WriteJSONError(w, statusCode, errDesc)
+		return
+	}
+
+	// Validate the request params
+	trxReq, validationErrs := ParseCreateTransactionRequest(req, h.Config, time.Now())
+	if validationErrs != nil {
+		logger.Log.Error("Validation failed for CreateTransaction request", zap.String("validation_errors", validationErrs.Error()))
 		writer.WriteJSONError(
 			w,
 			http.StatusBadRequest,
 			writer.ErrorDescription{
-				Title:  writer.ErrTitleInvalidRequestPayload,
+				Title: writer.ErrTilteValidationFailed,
+				Code:  writer.ErrCodeInvalidRequest,
+			},
+			validationErrs.FieldErrors()...,
+		)
+		return
+	}
+
+	// Validate the idempotency key
+	idempotencyKey := r.Header.Get(IdempotencyKeyHeader)
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		logger.Log.Error("Invalid idempotency key for CreateTransaction request")
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title:  writer.ErrTilteValidationFailed,
 				Code:   writer.ErrCodeInvalidRequest,
-				Detail: "The request payload is malformed or invalid.",
+				Detail: "Idempotency-Key header must be at most 255 characters in length.",
 			},
 		)
 		return
 	}
 
 	// Create a transaction
-	err := h.DataRepo.CreateTransaction(
-		r.Context(),
-		repository.CreateTransactionReqParams{
-			AccountID:       req.AccountID,
-			OperationTypeID: req.OperationTypeID,
-			Amount:          req.Amount,
-		})
+	if idempotencyKey != "" {
+		trxReq.IdempotencyKey = idempotencyKey
+		trxReq.RequestHash = hashRequest(trxReq)
+	}
+	dbResp, err := h.DataRepo.CreateTransaction(r.Context(), trxReq)
 	if err != nil {
 		// Handle errors
-		if errors.Is(err, repository.ErrAccountIDNotExists) || errors.Is(err, repository.ErrOperationTypeIDNotExists) {
+		if errors.Is(err, repository.ErrIdempotencyKeyConflict) {
+			logger.Log.Error("Idempotency key reused for a different request", zap.Error(err))
+			writer.WriteJSONError(
+				w,
+				http.StatusConflict,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleIdempotencyKey,
+					Code:   writer.ErrCodeIdempotencyKey,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		if errors.Is(err, repository.ErrInsufficientCreditLimit) {
+			logger.Log.Error("Failed to create transaction", zap.Error(err))
+			writer.WriteJSONError(
+				w,
+				http.StatusUnprocessableEntity,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleCreditLimit,
+					Code:   writer.ErrCodeCreditLimit,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		if errors.Is(err, repository.ErrAccountBlocked) || errors.Is(err, repository.ErrAccountClosed) {
+			logger.Log.Error("Failed to create transaction", zap.Error(err))
+			statusCode, errDesc := accountStatusError(err)
+			writer.WriteJSONError(w, statusCode, errDesc)
+			return
+		}
+
+		if errors.Is(err, repository.ErrAccountIDNotExists) ||
+			errors.Is(err, repository.ErrOperationTypeIDNotExists) ||
+			errors.Is(err, repository.ErrOperationTypeInactive) ||
+			errors.Is(err, repository.ErrSystemOperationType) ||
+			errors.Is(err, repository.ErrCurrencyMismatch) ||
+			errors.Is(err, repository.ErrInstallmentsNotAllowed) ||
+			errors.Is(err, repository.ErrInstallmentsExceedAmount) {
 			logger.Log.Error("Failed to create transaction", zap.Error(err))
 			writer.WriteJSONError(
 				w,
@@ -67,8 +137,10 @@ func (h *transactionsHandler) CreateTransaction(w http.ResponseWriter, r *http.R
 	}
 
 	// Send success response
-	resp := CreateTrxResponse{
-		Status: StatusSuccess,
+	resp := newTransactionResponse(dbResp)
+	w.Header().Set("Location", transactionLocation(resp.TransactionID))
+	if dbResp.Replayed {
+		w.Header().Set(IdempotentReplayedHeader, "true")
 	}
 	if err := writer.WriteJSON(w, http.StatusCreated, resp); err != nil {
 		logger.Log.Error("Error writting success response for CreateTransaction request", zap.Error(err))
@@ -85,13 +157,112 @@ func (h *transactionsHandler) CreateTransaction(w http.ResponseWriter, r *http.R
 	}
 }
 
-// Add valiadtion if requried
-// func validateCreateTransaction(req CreateTrxReqParams) *validator.ValidationErrors {
-// 	errors := validator.NewValidationErrors()
+// accountStatusError returns the status code and error description of a transaction which can't be
+// posted as its account is blocked or closed.
+func accountStatusError(err error) (int, writer.ErrorDescription) {
+	if errors.Is(err, repository.ErrAccountClosed) {
+		return http.StatusUnprocessableEntity, writer.ErrorDescription{
+			Title:  writer.ErrTitleAccountClosed,
+			Code:   writer.ErrCodeAccountClosed,
+			Detail: err.Error(),
+		}
+	}
+
+	return http.StatusUnprocessableEntity, writer.ErrorDescription{
+		Title:  writer.ErrTitleAccountBlocked,
+		Code:   writer.ErrCodeAccountBlocked,
+		Detail: err.Error(),
+	}
+}
+
+// ParseAmount validates the amount of the given field with the decimal places of its currency and converts it
+// to repository.Money, validating the amount in minor units with the given rules. The zero Money is returned for
+// an invalid amount. It's exported for the other handlers taking amounts, such as the credit limit of accounts.
+func ParseAmount(errors *validator.ValidationErrors, field string, req AmountReqParams, rules ...validator.Rule[int64]) repository.Money {
+	currencyField, valueField := field+".currency", field+".value"
+	validator.Field(errors, currencyField, req.Currency,
+		validator.Required[string](),
+		validator.Enum(repository.SupportedCurrencies()...),
+	)
+	valueRules := []validator.Rule[string]{validator.Required[string]()}
+	if exponent, err := repository.CurrencyExponent(req.Currency); err == nil {
+		valueRules = append(valueRules, validator.DecimalScale(exponent))
+	}
+	validator.Field(errors, valueField, req.Value.String(), valueRules...)
+	_, invalidCurrency := errors.Errors[currencyField]
+	_, invalidValue := errors.Errors[valueField]
+	if invalidCurrency || invalidValue {
+		return repository.Money{}
+	}
+
+	amount, err := repository.ParseMoney(req.Value.String(), req.Currency)
+	if err != nil {
+		errors.Add(valueField, "Must be a decimal number.")
+		return repository.Money{}
+	}
+	validator.Field(errors, valueField, amount.Amount, rules...)
+	return amount
+}
+
+// hashRequest returns the hex encoded SHA-256 hash of the validated request, used to detect
+// an idempotency key being reused with a different request.
+func hashRequest(trxReq repository.CreateTransactionReqParams) string {
+	req := idempotentRequest{
+		AccountID:       trxReq.AccountID,
+		OperationTypeID: trxReq.OperationTypeID,
+		Amount:          trxReq.Amount,
+		Installments:    trxReq.Installments,
+	}
+	if trxReq.EventDate != nil {
+		req.EventDate = trxReq.EventDate.Format(time.RFC3339Nano)
+	}
+
+	// Marshalling a struct of plain fields never fails
+	payload, _ := json.Marshal(req)
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:])
+}
+
+// ParseCreateTransactionRequest validates the request object for CreateTransaction API handler
+// and converts it to the repository request. The event date must be within the configured
+// backdating window and future skew from now. It's exported for the transactions imported from files.
+func ParseCreateTransactionRequest(req CreateTrxReqParams, config Config, now time.Time) (repository.CreateTransactionReqParams, *validator.ValidationErrors) {
+	errors := validator.NewValidationErrors()
+
+	trxReq := repository.CreateTransactionReqParams{
+		AccountID:       req.AccountID,
+		OperationTypeID: req.OperationTypeID,
+		Installments:    req.Installments,
+	}
+
+	validator.Field(errors, "account_id", req.AccountID, validator.Min(1))
+	validator.Field(errors, "operation_type_id", req.OperationTypeID, validator.Min(1))
+	validator.Field(errors, "installments", req.Installments, validator.Min(0), validator.Max(maxInstallments))
 
-// 	if len(errors.Errors) > 0 {
-// 		return errors
-// 	}
+	trxReq.Amount = ParseAmount(errors, "amount", req.Amount, validator.NotZero[int64]())
 
-// 	return nil
-// }
+	// Each installment is at least one minor unit of the amount, an invalid amount is parsed as zero
+	if trxReq.Amount.Amount != 0 && int64(req.Installments) > trxReq.Amount.Abs().Amount {
+		errors.Add("installments", fmt.Sprintf("Must be at most the amount in minor units, %d.", trxReq.Amount.Abs().Amount))
+	}
+
+	if req.EventDate != "" {
+		eventDate, err := time.Parse(time.RFC3339, req.EventDate)
+		switch {
+		case err != nil:
+			errors.Add("event_date", "Event date must be an RFC3339 timestamp.")
+		case eventDate.Before(now.Add(-config.EventDateBackdateWindow)):
+			errors.Add("event_date", fmt.Sprintf("Event date must not be more than %s in the past.", config.EventDateBackdateWindow))
+		case eventDate.After(now.Add(config.EventDateFutureSkew)):
+			errors.Add("event_date", fmt.Sprintf("Event date must not be more than %s in the future.", config.EventDateFutureSkew))
+		default:
+			trxReq.EventDate = &eventDate
+		}
+	}
+
+	if len(errors.Errors) > 0 {
+		return trxReq, errors
+	}
+
+	return trxReq, nil
+}
@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aswinudhayakumar/account-transactions/internal/logger"
 	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
@@ -21,6 +22,13 @@ const (
 	createTransactionEndpoint = "/app/v1/transactions"
 )
 
+// testConfig is the transactions API config used by the handler tests.
+var testConfig = Config{
+	EventDateBackdateWindow: 30 * 24 * time.Hour,
+	EventDateFutureSkew:     5 * time.Minute,
+	HoldTTL:                 7 * 24 * time.Hour,
+}
+
 // testCreateTransactionSuite is a test suite object to test CreateTransaction API handler.
 type testCreateTransactionSuite struct {
 	suite.Suite
@@ -35,7 +43,7 @@ type testCreateTransactionSuite struct {
 func (s *testCreateTransactionSuite) SetupTest() {
 	s.recorder = httptest.NewRecorder()
 	s.dataRepo = new(mocks.DataRepo)
-	s.trxHandler = NewTransactionsHandler(s.dataRepo)
+	s.trxHandler = NewTransactionsHandler(s.dataRepo, testConfig)
 
 	s.router = chi.NewRouter()
 	s.router.Post("/app/v1/transactions", s.trxHandler.CreateTransaction)
@@ -56,37 +64,51 @@ func TestCreateTransactionSuite(t *testing.T) {
 func (s *testCreateTransactionSuite) TestCreateTransactionSuccess() {
 	accountID := 1
 	operationTypeID := 1
-	amount := 100.50
+	amount := repository.Money{Amount: 10050, Currency: "BRL"}
+	t := time.Now()
 
 	s.dataRepo.Mock.On("CreateTransaction", mock.Anything, repository.CreateTransactionReqParams{
 		AccountID:       accountID,
 		OperationTypeID: operationTypeID,
 		Amount:          amount,
-	}).Return(nil)
+	}).Return(&repository.TransactionResponse{
+		TransactionID:   1,
+		AccountID:       accountID,
+		OperationTypeID: operationTypeID,
+		Amount:          -amount.Amount,
+		Currency:        amount.Currency,
+		EventDate:       t,
+		CreatedAt:       t,
+		UpdatedAt:       t,
+	}, nil)
 
 	reqBody := fmt.Sprintf(`{
 		"account_id": %d,
 		"operation_type_id": %d,
-		"amount": %f
-	}`, accountID, operationTypeID, amount)
+		"amount": {"value": "%s", "currency": "%s"}
+	}`, accountID, operationTypeID, amount, amount.Currency)
 	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
 
 	s.router.ServeHTTP(s.recorder, req)
 	s.Equal(http.StatusCreated, s.recorder.Code)
+	s.Equal("/app/v1/transactions/1", s.recorder.Header().Get("Location"))
+	s.Contains(s.recorder.Body.String(), `"amount":{"value":"-100.50","currency":"BRL"}`)
 }
 
 // @Failed testcase - statusCode (400)
 func (s *testCreateTransactionSuite) TestCreateTransactionInvalidRequest() {
 	accountID := 1
 	operationTypeID := 1
-	amount := 100.50
+	amount := repository.Money{Amount: 10050, Currency: "BRL"}
 
 	reqBody := fmt.Sprintf(`{
 		"account_id": %d,
 		"operation_type_id": %d,
-		"amount": %f,
-	}`, accountID, operationTypeID, amount)
+		"amount": {"value": "%s", "currency": "%s"},
+	}`, accountID, operationTypeID, amount, amount.Currency)
 	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
 
 	s.router.ServeHTTP(s.recorder, req)
 	s.Equal(http.StatusBadRequest, s.recorder.Code)
@@ -95,14 +117,15 @@ func (s *testCreateTransactionSuite) TestCreateTransactionInvalidRequest() {
 // @Failed testcase - statusCode (400)
 func (s *testCreateTransactionSuite) TestCreateTransactionInvalidAccountID() {
 	operationTypeID := 1
-	amount := 100.50
+	amount := repository.Money{Amount: 10050, Currency: "BRL"}
 
 	reqBody := fmt.Sprintf(`{
 		"account_id": "test",
 		"operation_type_id": %d,
-		"amount": %f
-	}`, operationTypeID, amount)
+		"amount": {"value": "%s", "currency": "%s"}
+	}`, operationTypeID, amount, amount.Currency)
 	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
 
 	s.router.ServeHTTP(s.recorder, req)
 	s.Equal(http.StatusBadRequest, s.recorder.Code)
@@ -111,14 +134,15 @@ func (s *testCreateTransactionSuite) TestCreateTransactionInvalidAccountID() {
 // @Failed testcase - statusCode (400)
 func (s *testCreateTransactionSuite) TestCreateTransactionInvalidOperationTypeID() {
 	accountID := 1
-	amount := 100.50
+	amount := repository.Money{Amount: 10050, Currency: "BRL"}
 
 	reqBody := fmt.Sprintf(`{
 		"account_id": %d,
 		"operation_type_id": "test",
-		"amount": %f,
-	}`, accountID, amount)
+		"amount": {"value": "%s", "currency": "%s"},
+	}`, accountID, amount, amount.Currency)
 	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
 
 	s.router.ServeHTTP(s.recorder, req)
 	s.Equal(http.StatusBadRequest, s.recorder.Code)
@@ -135,6 +159,34 @@ func (s *testCreateTransactionSuite) TestCreateTransactionInvalidAmount() {
 		"amount": "test"
 	}`, accountID, operationTypeID)
 	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateTransactionSuite) TestCreateTransactionInvalidAmountScale() {
+	reqBody := `{
+		"account_id": 1,
+		"operation_type_id": 1,
+		"amount": {"value": "100.005", "currency": "BRL"}
+	}`
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateTransactionSuite) TestCreateTransactionMissingAmount() {
+	reqBody := `{
+		"account_id": 1,
+		"operation_type_id": 1
+	}`
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
 
 	s.router.ServeHTTP(s.recorder, req)
 	s.Equal(http.StatusBadRequest, s.recorder.Code)
@@ -144,20 +196,21 @@ func (s *testCreateTransactionSuite) TestCreateTransactionInvalidAmount() {
 func (s *testCreateTransactionSuite) TestCreateTransactionAccountIDNotFound() {
 	accountID := 1
 	operationTypeID := 1
-	amount := 100.50
+	amount := repository.Money{Amount: 10050, Currency: "BRL"}
 
 	s.dataRepo.Mock.On("CreateTransaction", mock.Anything, repository.CreateTransactionReqParams{
 		AccountID:       accountID,
 		OperationTypeID: operationTypeID,
 		Amount:          amount,
-	}).Return(repository.ErrAccountIDNotExists)
+	}).Return(nil, repository.ErrAccountIDNotExists)
 
 	reqBody := fmt.Sprintf(`{
 		"account_id": %d,
 		"operation_type_id": %d,
-		"amount": %f
-	}`, accountID, operationTypeID, amount)
+		"amount": {"value": "%s", "currency": "%s"}
+	}`, accountID, operationTypeID, amount, amount.Currency)
 	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
 
 	s.router.ServeHTTP(s.recorder, req)
 	s.Equal(http.StatusBadRequest, s.recorder.Code)
@@ -167,44 +220,376 @@ func (s *testCreateTransactionSuite) TestCreateTransactionAccountIDNotFound() {
 func (s *testCreateTransactionSuite) TestCreateTransactionOperationTypeIDNotFound() {
 	accountID := 1
 	operationTypeID := 1
-	amount := 100.50
+	amount := repository.Money{Amount: 10050, Currency: "BRL"}
 
 	s.dataRepo.Mock.On("CreateTransaction", mock.Anything, repository.CreateTransactionReqParams{
 		AccountID:       accountID,
 		OperationTypeID: operationTypeID,
 		Amount:          amount,
-	}).Return(repository.ErrOperationTypeIDNotExists)
+	}).Return(nil, repository.ErrOperationTypeIDNotExists)
 
 	reqBody := fmt.Sprintf(`{
 		"account_id": %d,
 		"operation_type_id": %d,
-		"amount": %f
-	}`, accountID, operationTypeID, amount)
+		"amount": {"value": "%s", "currency": "%s"}
+	}`, accountID, operationTypeID, amount, amount.Currency)
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateTransactionSuite) TestCreateTransactionCurrencyMismatch() {
+	s.dataRepo.Mock.On("CreateTransaction", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrCurrencyMismatch)
+
+	reqBody := `{"account_id": 1, "operation_type_id": 1, "amount": {"value": "10", "currency": "USD"}}`
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (422)
+func (s *testCreateTransactionSuite) TestCreateTransactionInsufficientCreditLimit() {
+	s.dataRepo.Mock.On("CreateTransaction", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrInsufficientCreditLimit)
+
+	reqBody := `{"account_id": 1, "operation_type_id": 1, "amount": {"value": "10", "currency": "BRL"}}`
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusUnprocessableEntity, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (422)
+func (s *testCreateTransactionSuite) TestCreateTransactionAccountBlocked() {
+	s.dataRepo.Mock.On("CreateTransaction", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrAccountBlocked)
+
+	reqBody := `{"account_id": 1, "operation_type_id": 1, "amount": {"value": "10", "currency": "BRL"}}`
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusUnprocessableEntity, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"code":"account_blocked"`)
+}
+
+// @Failed testcase - statusCode (422)
+func (s *testCreateTransactionSuite) TestCreateTransactionAccountClosed() {
+	s.dataRepo.Mock.On("CreateTransaction", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrAccountClosed)
+
+	reqBody := `{"account_id": 1, "operation_type_id": 1, "amount": {"value": "10", "currency": "BRL"}}`
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusUnprocessableEntity, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"code":"account_closed"`)
+}
+
+// @Success testcase - statusCode (201)
+func (s *testCreateTransactionSuite) TestCreateTransactionWithInstallments() {
+	amount := repository.Money{Amount: 30000, Currency: "BRL"}
+	t := time.Now()
+
+	s.dataRepo.Mock.On("CreateTransaction", mock.Anything, repository.CreateTransactionReqParams{
+		AccountID:       1,
+		OperationTypeID: 2,
+		Amount:          amount,
+		Installments:    3,
+	}).Return(&repository.TransactionResponse{
+		TransactionID:   1,
+		AccountID:       1,
+		OperationTypeID: 2,
+		Amount:          -amount.Amount,
+		Currency:        amount.Currency,
+		Balance:         -amount.Amount,
+		EventDate:       t,
+		CreatedAt:       t,
+		UpdatedAt:       t,
+	}, nil)
+
+	reqBody := `{"account_id": 1, "operation_type_id": 2, "amount": {"value": "300.00", "currency": "BRL"}, "installments": 3}`
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusCreated, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateTransactionSuite) TestCreateTransactionInvalidInstallments() {
+	reqBody := `{"account_id": 1, "operation_type_id": 2, "amount": {"value": "300.00", "currency": "BRL"}, "installments": 49}`
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateTransactionSuite) TestCreateTransactionInstallmentsExceedAmount() {
+	reqBody := `{"account_id": 1, "operation_type_id": 2, "amount": {"value": "0.05", "currency": "BRL"}, "installments": 48}`
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"installments","message":"Must be at most the amount in minor units, 5."}`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateTransactionSuite) TestCreateTransactionSystemOperationType() {
+	s.dataRepo.Mock.On("CreateTransaction", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrSystemOperationType)
+
+	reqBody := `{"account_id": 1, "operation_type_id": 6, "amount": {"value": "300.00", "currency": "BRL"}}`
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), repository.ErrSystemOperationType.Error())
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateTransactionSuite) TestCreateTransactionInstallmentsNotAllowed() {
+	s.dataRepo.Mock.On("CreateTransaction", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrInstallmentsNotAllowed)
+
+	reqBody := `{"account_id": 1, "operation_type_id": 1, "amount": {"value": "300.00", "currency": "BRL"}, "installments": 3}`
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Success testcase - statusCode (201)
+func (s *testCreateTransactionSuite) TestCreateTransactionWithEventDate() {
+	eventDate := time.Now().Add(-48 * time.Hour).UTC().Truncate(time.Second)
+
+	s.dataRepo.Mock.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req repository.CreateTransactionReqParams) bool {
+		return req.EventDate != nil && req.EventDate.Equal(eventDate)
+	})).Return(&repository.TransactionResponse{
+		TransactionID:   1,
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          -1000,
+		Currency:        "BRL",
+		EventDate:       eventDate,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}, nil)
+
+	reqBody := fmt.Sprintf(`{"account_id": 1, "operation_type_id": 1, "amount": {"value": "10", "currency": "BRL"}, "event_date": "%s"}`, eventDate.Format(time.RFC3339))
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusCreated, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), fmt.Sprintf(`"event_date":"%s"`, eventDate.Format(time.RFC3339)))
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateTransactionSuite) TestCreateTransactionInvalidEventDate() {
+	testcases := []struct {
+		eventDate string
+		message   string
+	}{
+		{"2025-01-31 10:00:00", "Event date must be an RFC3339 timestamp."},
+		{time.Now().Add(-31 * 24 * time.Hour).Format(time.RFC3339), "Event date must not be more than 720h0m0s in the past."},
+		{time.Now().Add(time.Hour).Format(time.RFC3339), "Event date must not be more than 5m0s in the future."},
+	}
+
+	for _, tc := range testcases {
+		recorder := httptest.NewRecorder()
+		reqBody := fmt.Sprintf(`{"account_id": 1, "operation_type_id": 1, "amount": {"value": "10", "currency": "BRL"}, "event_date": "%s"}`, tc.eventDate)
+		req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		s.router.ServeHTTP(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code, tc.eventDate)
+		s.Contains(recorder.Body.String(), fmt.Sprintf(`"source":{"field":"event_date","message":"%s"}`, tc.message), tc.eventDate)
+	}
+	s.dataRepo.AssertNotCalled(s.T(), "CreateTransaction", mock.Anything, mock.Anything)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateTransactionSuite) TestCreateTransactionFieldErrors() {
+	reqBody := `{"account_id": 1, "operation_type_id": 1, "installments": -1, "event_date": "yesterday"}`
 	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
 
 	s.router.ServeHTTP(s.recorder, req)
 	s.Equal(http.StatusBadRequest, s.recorder.Code)
+
+	// One error is returned per invalid field, sorted by field
+	body := s.recorder.Body.String()
+	currencyIdx := strings.Index(body, `"field":"amount.currency"`)
+	valueIdx := strings.Index(body, `"field":"amount.value"`)
+	eventDateIdx := strings.Index(body, `"field":"event_date"`)
+	installmentsIdx := strings.Index(body, `"field":"installments"`)
+	s.True(currencyIdx >= 0 && currencyIdx < valueIdx && valueIdx < eventDateIdx && eventDateIdx < installmentsIdx, body)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateTransactionSuite) TestCreateTransactionInvalidFields() {
+	testcases := []struct {
+		reqBody string
+		field   string
+		message string
+	}{
+		{`{"account_id": 1, "operation_type_id": 1, "amount": {"value": "0.00", "currency": "BRL"}}`, "amount.value", "Must not be zero."},
+		{`{"account_id": -1, "operation_type_id": 1, "amount": {"value": "10", "currency": "BRL"}}`, "account_id", "Must be at least 1."},
+		{`{"account_id": 1, "amount": {"value": "10", "currency": "BRL"}}`, "operation_type_id", "Must be at least 1."},
+		{`{"account_id": 1, "operation_type_id": 1, "amount": {"value": "10", "currency": "XYZ"}}`, "amount.currency", "Must be one of ARS, BRL, CLP, COP, EUR, GBP, JPY, KWD, MXN, USD."},
+		{`{"account_id": 1, "operation_type_id": 1, "amount": {"value": "10.001", "currency": "BRL"}}`, "amount.value", "Must have at most 2 decimal places."},
+	}
+
+	for _, tc := range testcases {
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(tc.reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		s.router.ServeHTTP(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code, tc.reqBody)
+		s.Contains(recorder.Body.String(), fmt.Sprintf(`"source":{"field":"%s","message":"%s"}`, tc.field, tc.message), tc.reqBody)
+	}
+	s.dataRepo.AssertNotCalled(s.T(), "CreateTransaction", mock.Anything, mock.Anything)
 }
 
 // @Failed testcase - statusCode (500)
 func (s *testCreateTransactionSuite) TestCreateTransactionInternalServerError() {
 	accountID := 1
 	operationTypeID := 1
-	amount := 100.50
+	amount := repository.Money{Amount: 10050, Currency: "BRL"}
 
 	s.dataRepo.Mock.On("CreateTransaction", mock.Anything, repository.CreateTransactionReqParams{
 		AccountID:       accountID,
 		OperationTypeID: operationTypeID,
 		Amount:          amount,
-	}).Return(errors.New("something went wrong"))
+	}).Return(nil, errors.New("something went wrong"))
 
 	reqBody := fmt.Sprintf(`{
 		"account_id": %d,
 		"operation_type_id": %d,
-		"amount": %f
-	}`, accountID, operationTypeID, amount)
+		"amount": {"value": "%s", "currency": "%s"}
+	}`, accountID, operationTypeID, amount, amount.Currency)
 	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
 
 	s.router.ServeHTTP(s.recorder, req)
 	s.Equal(http.StatusInternalServerError, s.recorder.Code)
 }
+
+// @Success testcase - statusCode (201)
+func (s *testCreateTransactionSuite) TestCreateTransactionIdempotentReplay() {
+	t := time.Now()
+	trxReq := repository.CreateTransactionReqParams{
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          repository.Money{Amount: 10050, Currency: "BRL"},
+	}
+
+	s.dataRepo.Mock.On("CreateTransaction", mock.Anything, repository.CreateTransactionReqParams{
+		AccountID:       trxReq.AccountID,
+		OperationTypeID: trxReq.OperationTypeID,
+		Amount:          trxReq.Amount,
+		IdempotencyKey:  "key-1",
+		RequestHash:     hashRequest(trxReq),
+	}).Return(&repository.TransactionResponse{
+		TransactionID:   1,
+		AccountID:       trxReq.AccountID,
+		OperationTypeID: trxReq.OperationTypeID,
+		Amount:          -trxReq.Amount.Amount,
+		Currency:        trxReq.Amount.Currency,
+		EventDate:       t,
+		CreatedAt:       t,
+		UpdatedAt:       t,
+		Replayed:        true,
+	}, nil)
+
+	reqBody := `{"account_id": 1, "operation_type_id": 1, "amount": {"value": "100.50", "currency": "BRL"}}`
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(IdempotencyKeyHeader, "key-1")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusCreated, s.recorder.Code)
+	s.Equal("true", s.recorder.Header().Get(IdempotentReplayedHeader))
+}
+
+// @Failed testcase - statusCode (409)
+func (s *testCreateTransactionSuite) TestCreateTransactionIdempotencyKeyConflict() {
+	s.dataRepo.Mock.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req repository.CreateTransactionReqParams) bool {
+		return req.IdempotencyKey == "key-1" && req.RequestHash != ""
+	})).Return(nil, repository.ErrIdempotencyKeyConflict)
+
+	reqBody := `{"account_id": 1, "operation_type_id": 1, "amount": {"value": "200", "currency": "BRL"}}`
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(IdempotencyKeyHeader, "key-1")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusConflict, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateTransactionSuite) TestCreateTransactionIdempotencyKeyTooLong() {
+	reqBody := `{"account_id": 1, "operation_type_id": 1, "amount": {"value": "200", "currency": "BRL"}}`
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(IdempotencyKeyHeader, strings.Repeat("k", maxIdempotencyKeyLength+1))
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateTransactionSuite) TestCreateTransactionUnknownField() {
+	reqBody := `{"account_id": 1, "operation_type_id": 1, "ammount": {"value": "10", "currency": "BRL"}}`
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.dataRepo.AssertNotCalled(s.T(), "CreateTransaction", mock.Anything, mock.Anything)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateTransactionSuite) TestCreateTransactionTrailingData() {
+	reqBody := `{"account_id": 1, "operation_type_id": 1, "amount": {"value": "10", "currency": "BRL"}}garbage`
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.dataRepo.AssertNotCalled(s.T(), "CreateTransaction", mock.Anything, mock.Anything)
+}
+
+// @Failed testcase - statusCode (413)
+func (s *testCreateTransactionSuite) TestCreateTransactionRequestTooLarge() {
+	reqBody := fmt.Sprintf(`{"account_id": 1, "operation_type_id": 1, "event_date": "%s"}`, strings.Repeat("x", 2<<20))
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusRequestEntityTooLarge, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (415)
+func (s *testCreateTransactionSuite) TestCreateTransactionUnsupportedMediaType() {
+	reqBody := `{"account_id": 1, "operation_type_id": 1, "amount": {"value": "10", "currency": "BRL"}}`
+	req := httptest.NewRequest(http.MethodPost, createTransactionEndpoint, strings.NewReader(reqBody))
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusUnsupportedMediaType, s.recorder.Code)
+}
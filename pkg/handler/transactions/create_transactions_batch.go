@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/decoder"
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/validator"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"go.uber.org/zap"
+)
+
+// CreateTransactionsBatch handles the creation of a batch of transactions, returning the result of each
+// transaction keyed by its index in the batch. In atomic mode either all of the transactions are created
+// or none, and in partial mode each transaction is created unless it fails on its own.
+func (h *transactionsHandler) CreateTransactionsBatch(w http.ResponseWriter, r *http.Request) {
+	// Decode the request params
+	var req CreateTrxBatchReqParams
+	if err := decoder.DecodeJSON(w, r, &req); err != nil {
+		logger.Log.Error("Failed to decode HTTP request payload", zap.Error(err))
+		statusCode, errDesc := decoder.ErrorResponse(err)
+		writer.WriteJSONError(w, statusCode, errDesc)
+		return
+	}
+
+	// Validate the batch
+	if validationErrs := validateCreateTrxBatchRequest(req); validationErrs != nil {
+		logger.Log.Error("Validation failed for CreateTransactionsBatch request", zap.String("validation_errors", validationErrs.Error()))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title: writer.ErrTilteValidationFailed,
+				Code:  writer.ErrCodeInvalidRequest,
+			},
+			validationErrs.FieldErrors()...,
+		)
+		return
+	}
+	atomic := req.Mode == BatchModeAtomic
+
+	// Validate each transaction, the invalid ones get their result right away
+	resp := BatchResponse{
+		Mode:    req.Mode,
+		Results: make([]BatchResultResponse, len(req.Transactions)),
+	}
+	var (
+		indexes []int
+		batch   = repository.CreateTransactionsReqParams{Atomic: atomic}
+		now     = time.Now()
+	)
+	for i, trx := range req.Transactions {
+		resp.Results[i].Index = i
+
+		trxReq, validationErrs := ParseCreateTransactionRequest(trx, h.Config, now)
+		if validationErrs != nil {
+			resp.Results[i].Status = http.StatusBadRequest
+			resp.Results[i].Errors, _ = writer.NewErrorDescriptions(
+				http.StatusBadRequest,
+				writer.ErrorDescription{
+					Title: writer.ErrTilteValidationFailed,
+					Code:  writer.ErrCodeInvalidRequest,
+				},
+				validationErrs.FieldErrors()...,
+			)
+			continue
+		}
+
+		indexes = append(indexes, i)
+		batch.Transactions = append(batch.Transactions, trxReq)
+	}
+
+	if atomic && len(indexes) < len(req.Transactions) {
+		logger.Log.Error("Validation failed for CreateTransactionsBatch request")
+		writeBatchRejected(w, http.StatusBadRequest, resp)
+		return
+	}
+
+	// Create the valid transactions
+	if len(batch.Transactions) > 0 {
+		dbResp, err := h.DataRepo.CreateTransactions(r.Context(), batch)
+		if err != nil && !errors.Is(err, repository.ErrBatchRejected) {
+			logger.Log.Error("Database call failed for CreateTransactionsBatch request", zap.Error(err))
+			writer.WriteJSONError(
+				w,
+				http.StatusInternalServerError,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleUnexpectedError,
+					Code:   writer.ErrCodeUnexpectedError,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		for k, i := range indexes {
+			result := &resp.Results[i]
+			if dbResp[k].Err != nil {
+				result.Status, result.Errors = batchItemErrors(dbResp[k].Err)
+				continue
+			}
+			if dbResp[k].Transaction != nil {
+				trxResp := newTransactionResponse(dbResp[k].Transaction)
+				result.Status = http.StatusCreated
+				result.Transaction = &trxResp
+			}
+		}
+
+		if err != nil {
+			logger.Log.Error("Batch rejected for CreateTransactionsBatch request", zap.Error(err))
+			writeBatchRejected(w, http.StatusUnprocessableEntity, resp)
+			return
+		}
+	}
+
+	// Send success response, every transaction of an atomic batch is created
+	statusCode := http.StatusOK
+	if atomic {
+		statusCode = http.StatusCreated
+	}
+	if err := writer.WriteJSON(w, statusCode, resp); err != nil {
+		logger.Log.Error("Error writting success response for CreateTransactionsBatch request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+}
+
+// validateCreateTrxBatchRequest validates the batch level params of the request object for CreateTransactionsBatch API handler.
+func validateCreateTrxBatchRequest(req CreateTrxBatchReqParams) *validator.ValidationErrors {
+	errors := validator.NewValidationErrors()
+
+	validator.Field(errors, "mode", req.Mode, validator.Required[string](), validator.Enum(BatchModeAtomic, BatchModePartial))
+	validator.Field(errors, "transactions", len(req.Transactions), validator.Min(1), validator.Max(maxBatchSize))
+
+	if len(errors.Errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// writeBatchRejected writes the response of a rejected atomic batch. The transactions without
+// errors of their own are marked as failed because of the rest of the batch.
+func writeBatchRejected(w http.ResponseWriter, statusCode int, resp BatchResponse) {
+	for i := range resp.Results {
+		result := &resp.Results[i]
+		if result.Errors != nil {
+			continue
+		}
+		result.Status = http.StatusFailedDependency
+		result.Transaction = nil
+		result.Errors, _ = writer.NewErrorDescriptions(
+			http.StatusFailedDependency,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleBatchRejected,
+				Code:   writer.ErrCodeBatchRejected,
+				Detail: "Transaction not created as other transactions of the batch failed.",
+			},
+		)
+	}
+
+	if err := writer.WriteJSON(w, statusCode, resp); err != nil {
+		logger.Log.Error("Error writting rejected response for CreateTransactionsBatch request", zap.Error(err))
+	}
+}
+
+// batchItemErrors returns the status code and error descriptions of a transaction of a batch
+// which failed to be created.
+func batchItemErrors(err error) (int, []writer.ErrorDescription) {
+	statusCode, errDesc := http.StatusBadRequest, writer.ErrorDescription{
+		Title:  writer.ErrTitleInvalidRequestPayload,
+		Code:   writer.ErrCodeInvalidRequest,
+		Detail: err.Error(),
+	}
+	if errors.Is(err, repository.ErrInsufficientCreditLimit) {
+		statusCode, errDesc = http.StatusUnprocessableEntity, writer.ErrorDescription{
+			Title:  writer.ErrTitleCreditLimit,
+			Code:   writer.ErrCodeCreditLimit,
+			Detail: err.Error(),
+		}
+	}
+	if errors.Is(err, repository.ErrAccountBlocked) || errors.Is(err, repository.ErrAccountClosed) {
+		statusCode, errDesc = accountStatusError(err)
+	}
+
+	errDescs, _ := writer.NewErrorDescriptions(statusCode, errDesc)
+	return statusCode, errDescs
+}
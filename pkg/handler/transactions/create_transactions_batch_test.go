@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	createTransactionsBatchEndpoint = "/app/v1/transactions:batch"
+)
+
+// testCreateTransactionsBatchSuite is a test suite object to test CreateTransactionsBatch API handler.
+type testCreateTransactionsBatchSuite struct {
+	suite.Suite
+
+	dataRepo   *mocks.DataRepo
+	router     *chi.Mux
+	trxHandler TransactionsHandler
+	recorder   *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testCreateTransactionsBatchSuite.
+func (s *testCreateTransactionsBatchSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.trxHandler = NewTransactionsHandler(s.dataRepo, testConfig)
+
+	s.router = chi.NewRouter()
+	s.router.Post("/app/v1/transactions:batch", s.trxHandler.CreateTransactionsBatch)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestCreateTransactionsBatchSuite is the custom test suite runner for CreateTransactionsBatch API handler.
+func TestCreateTransactionsBatchSuite(t *testing.T) {
+	suite.Run(t, new(testCreateTransactionsBatchSuite))
+}
+
+// batchReqBody returns a batch of a valid debit, an invalid zero amount debit and a valid credit.
+func batchReqBody(mode string) string {
+	return fmt.Sprintf(`{"mode": "%s", "transactions": [
+		{"account_id": 1, "operation_type_id": 1, "amount": {"value": "50.00", "currency": "BRL"}},
+		{"account_id": 1, "operation_type_id": 1, "amount": {"value": "0", "currency": "BRL"}},
+		{"account_id": 1, "operation_type_id": 4, "amount": {"value": "30.00", "currency": "BRL"}}
+	]}`, mode)
+}
+
+// createdTransaction returns a created transaction with the given ID and signed amount.
+func createdTransaction(transactionID int, operationTypeID int, amount int64) *repository.TransactionResponse {
+	t := time.Now()
+	return &repository.TransactionResponse{
+		TransactionID:   transactionID,
+		AccountID:       1,
+		OperationTypeID: operationTypeID,
+		Amount:          amount,
+		Currency:        "BRL",
+		Balance:         amount,
+		Status:          repository.TransactionStatusPosted,
+		EventDate:       t,
+		CreatedAt:       t,
+		UpdatedAt:       t,
+	}
+}
+
+// @Success testcase - statusCode (201)
+func (s *testCreateTransactionsBatchSuite) TestCreateTransactionsBatchAtomicSuccess() {
+	reqBody := `{"mode": "atomic", "transactions": [
+		{"account_id": 1, "operation_type_id": 1, "amount": {"value": "50.00", "currency": "BRL"}},
+		{"account_id": 1, "operation_type_id": 4, "amount": {"value": "30.00", "currency": "BRL"}}
+	]}`
+	s.dataRepo.Mock.On("CreateTransactions", mock.Anything, repository.CreateTransactionsReqParams{
+		Transactions: []repository.CreateTransactionReqParams{
+			{AccountID: 1, OperationTypeID: 1, Amount: repository.Money{Amount: 5000, Currency: "BRL"}},
+			{AccountID: 1, OperationTypeID: 4, Amount: repository.Money{Amount: 3000, Currency: "BRL"}},
+		},
+		Atomic: true,
+	}).Return([]repository.BatchTransactionResult{
+		{Transaction: createdTransaction(10, 1, -5000)},
+		{Transaction: createdTransaction(11, 4, 3000)},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, createTransactionsBatchEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusCreated, s.recorder.Code)
+	body := s.recorder.Body.String()
+	s.Contains(body, `"index":0,"status":201,"transaction":{"transaction_id":10`)
+	s.Contains(body, `"index":1,"status":201,"transaction":{"transaction_id":11`)
+}
+
+// @Success testcase - statusCode (200)
+func (s *testCreateTransactionsBatchSuite) TestCreateTransactionsBatchPartialSuccess() {
+	s.dataRepo.Mock.On("CreateTransactions", mock.Anything, repository.CreateTransactionsReqParams{
+		Transactions: []repository.CreateTransactionReqParams{
+			{AccountID: 1, OperationTypeID: 1, Amount: repository.Money{Amount: 5000, Currency: "BRL"}},
+			{AccountID: 1, OperationTypeID: 4, Amount: repository.Money{Amount: 3000, Currency: "BRL"}},
+		},
+	}).Return([]repository.BatchTransactionResult{
+		{Err: repository.ErrInsufficientCreditLimit},
+		{Transaction: createdTransaction(11, 4, 3000)},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, createTransactionsBatchEndpoint, strings.NewReader(batchReqBody(BatchModePartial)))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	body := s.recorder.Body.String()
+	s.Contains(body, `"index":0,"status":422,"errors":[{`)
+	s.Contains(body, `"code":"insufficient_credit_limit"`)
+	s.Contains(body, `"index":1,"status":400,"errors":[{`)
+	s.Contains(body, `"source":{"field":"amount.value","message":"Must not be zero."}`)
+	s.Contains(body, `"index":2,"status":201,"transaction":{"transaction_id":11`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateTransactionsBatchSuite) TestCreateTransactionsBatchAtomicInvalidTransaction() {
+	req := httptest.NewRequest(http.MethodPost, createTransactionsBatchEndpoint, strings.NewReader(batchReqBody(BatchModeAtomic)))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	body := s.recorder.Body.String()
+	s.Contains(body, `"index":0,"status":424`)
+	s.Contains(body, `"index":1,"status":400`)
+	s.Contains(body, `"index":2,"status":424`)
+	s.dataRepo.AssertNotCalled(s.T(), "CreateTransactions", mock.Anything, mock.Anything)
+}
+
+// @Failed testcase - statusCode (422)
+func (s *testCreateTransactionsBatchSuite) TestCreateTransactionsBatchAtomicRejected() {
+	reqBody := `{"mode": "atomic", "transactions": [
+		{"account_id": 1, "operation_type_id": 1, "amount": {"value": "50.00", "currency": "BRL"}},
+		{"account_id": 2, "operation_type_id": 1, "amount": {"value": "30.00", "currency": "BRL"}}
+	]}`
+	s.dataRepo.Mock.On("CreateTransactions", mock.Anything, mock.Anything).Return([]repository.BatchTransactionResult{
+		{},
+		{Err: repository.ErrAccountIDNotExists},
+	}, fmt.Errorf("failed to complete the db transaction: %w", repository.ErrBatchRejected))
+
+	req := httptest.NewRequest(http.MethodPost, createTransactionsBatchEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusUnprocessableEntity, s.recorder.Code)
+	body := s.recorder.Body.String()
+	s.Contains(body, `"index":0,"status":424`)
+	s.Contains(body, `"code":"batch_rejected"`)
+	s.Contains(body, `"index":1,"status":400`)
+	s.Contains(body, `"detail":"account id not exists"`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateTransactionsBatchSuite) TestCreateTransactionsBatchInvalidBatch() {
+	testcases := []struct {
+		reqBody string
+		field   string
+		message string
+	}{
+		{`{"transactions": [{"account_id": 1, "operation_type_id": 1, "amount": {"value": "10", "currency": "BRL"}}]}`, "mode", "This field is required."},
+		{`{"mode": "all", "transactions": [{"account_id": 1, "operation_type_id": 1, "amount": {"value": "10", "currency": "BRL"}}]}`, "mode", "Must be one of atomic, partial."},
+		{`{"mode": "atomic", "transactions": []}`, "transactions", "Must be at least 1."},
+	}
+
+	for _, tc := range testcases {
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, createTransactionsBatchEndpoint, strings.NewReader(tc.reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		s.router.ServeHTTP(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code, tc.reqBody)
+		s.Contains(recorder.Body.String(), fmt.Sprintf(`"source":{"field":"%s","message":"%s"}`, tc.field, tc.message), tc.reqBody)
+	}
+	s.dataRepo.AssertNotCalled(s.T(), "CreateTransactions", mock.Anything, mock.Anything)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testCreateTransactionsBatchSuite) TestCreateTransactionsBatchInternalServerError() {
+	s.dataRepo.Mock.On("CreateTransactions", mock.Anything, mock.Anything).Return(nil, errors.New("something went wrong"))
+
+	req := httptest.NewRequest(http.MethodPost, createTransactionsBatchEndpoint, strings.NewReader(batchReqBody(BatchModePartial)))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
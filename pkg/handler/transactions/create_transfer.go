@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/decoder"
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/validator"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"go.uber.org/zap"
+)
+
+// CreateTransfer handles the transfer of an amount from a source account to a destination account,
+// returning the transfer with its debit and credit transactions.
+func (h *transactionsHandler) CreateTransfer(w http.ResponseWriter, r *http.Request) {
+	// Decode the request params
+	var req CreateTransferReqParams
+	if err := decoder.DecodeJSON(w, r, &req); err != nil {
+		logger.Log.Error("Failed to decode HTTP request payload", zap.Error(err))
+		statusCode, errDesc := decoder.ErrorResponse(err)
+		writer.WriteJSONError(w, statusCode, errDesc)
+		return
+	}
+
+	// Validate the request params
+	transferReq, validationErrs := parseCreateTransferRequest(req)
+	if validationErrs != nil {
+		logger.Log.Error("Validation failed for CreateTransfer request", zap.String("validation_errors", validationErrs.Error()))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title: writer.ErrTilteValidationFailed,
+				Code:  writer.ErrCodeInvalidRequest,
+			},
+			validationErrs.FieldErrors()...,
+		)
+		return
+	}
+
+	// Create the transfer
+	dbResp, err := h.DataRepo.CreateTransfer(r.Context(), transferReq)
+	if err != nil {
+		// Handle errors
+		if errors.Is(err, repository.ErrInsufficientCreditLimit) {
+			logger.Log.Error("Failed to create transfer", zap.Error(err))
+			writer.WriteJSONError(
+				w,
+				http.StatusUnprocessableEntity,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleCreditLimit,
+					Code:   writer.ErrCodeCreditLimit,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		if errors.Is(err, repository.ErrAccountBlocked) || errors.Is(err, repository.ErrAccountClosed) {
+			logger.Log.Error("Failed to create transfer", zap.Error(err))
+			statusCode, errDesc := accountStatusError(err)
+			writer.WriteJSONError(w, statusCode, errDesc)
+			return
+		}
+
+		if errors.Is(err, repository.ErrAccountIDNotExists) ||
+			errors.Is(err, repository.ErrOperationTypeInactive) ||
+			errors.Is(err, repository.ErrCurrencyMismatch) ||
+			errors.Is(err, repository.ErrTransferSameAccount) {
+			logger.Log.Error("Failed to create transfer", zap.Error(err))
+			writer.WriteJSONError(
+				w,
+				http.StatusBadRequest,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleInvalidRequestPayload,
+					Code:   writer.ErrCodeInvalidRequest,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		logger.Log.Error("Database call failed for CreateTransfer request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Send success response
+	if err := writer.WriteJSON(w, http.StatusCreated, newTransferResponse(dbResp)); err != nil {
+		logger.Log.Error("Error writting success response for CreateTransfer request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+}
+
+// parseCreateTransferRequest validates the request object for CreateTransfer API handler
+// and converts it to the repository request.
+func parseCreateTransferRequest(req CreateTransferReqParams) (repository.CreateTransferReqParams, *validator.ValidationErrors) {
+	errors := validator.NewValidationErrors()
+
+	transferReq := repository.CreateTransferReqParams{
+		SourceAccountID:      req.SourceAccountID,
+		DestinationAccountID: req.DestinationAccountID,
+	}
+
+	validator.Field(errors, "source_account_id", req.SourceAccountID, validator.Min(1))
+	validator.Field(errors, "destination_account_id", req.DestinationAccountID, validator.Min(1))
+	if req.SourceAccountID > 0 && req.DestinationAccountID == req.SourceAccountID {
+		errors.Add("destination_account_id", "Must be different from the source account.")
+	}
+
+	transferReq.Amount = ParseAmount(errors, "amount", req.Amount, validator.Positive[int64]())
+
+	if len(errors.Errors) > 0 {
+		return transferReq, errors
+	}
+
+	return transferReq, nil
+}
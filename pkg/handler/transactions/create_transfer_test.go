@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	createTransferEndpoint = "/app/v1/transfers"
+)
+
+// testCreateTransferSuite is a test suite object to test CreateTransfer API handler.
+type testCreateTransferSuite struct {
+	suite.Suite
+
+	dataRepo   *mocks.DataRepo
+	router     *chi.Mux
+	trxHandler TransactionsHandler
+	recorder   *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testCreateTransferSuite.
+func (s *testCreateTransferSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.trxHandler = NewTransactionsHandler(s.dataRepo, testConfig)
+
+	s.router = chi.NewRouter()
+	s.router.Post(createTransferEndpoint, s.trxHandler.CreateTransfer)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestCreateTransferSuite is the custom test suite runner for CreateTransfer API handler.
+func TestCreateTransferSuite(t *testing.T) {
+	suite.Run(t, new(testCreateTransferSuite))
+}
+
+// postTransfer sends the CreateTransfer request with the given body.
+func (s *testCreateTransferSuite) postTransfer(reqBody string) {
+	req := httptest.NewRequest(http.MethodPost, createTransferEndpoint, strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	s.router.ServeHTTP(s.recorder, req)
+}
+
+// @Success testcase - statusCode (201)
+func (s *testCreateTransferSuite) TestCreateTransferSuccess() {
+	t := time.Date(2025, 2, 14, 11, 0, 0, 0, time.UTC)
+	transferID := 7
+
+	s.dataRepo.Mock.On("CreateTransfer", mock.Anything, repository.CreateTransferReqParams{
+		SourceAccountID:      2,
+		DestinationAccountID: 1,
+		Amount:               repository.Money{Amount: 3000, Currency: "BRL"},
+	}).Return(&repository.TransferResponse{
+		TransferID:           transferID,
+		SourceAccountID:      2,
+		DestinationAccountID: 1,
+		Amount:               3000,
+		Currency:             "BRL",
+		CreatedAt:            t,
+		Debit: repository.TransactionResponse{
+			TransactionID: 20, AccountID: 2, OperationTypeID: 5, Amount: -3000, Currency: "BRL", Balance: -3000,
+			Status: repository.TransactionStatusPosted, TransferID: &transferID, EventDate: t, CreatedAt: t, UpdatedAt: t,
+		},
+		Credit: repository.TransactionResponse{
+			TransactionID: 21, AccountID: 1, OperationTypeID: 6, Amount: 3000, Currency: "BRL", Balance: 2000,
+			Status: repository.TransactionStatusPosted, TransferID: &transferID, EventDate: t, CreatedAt: t, UpdatedAt: t,
+		},
+	}, nil)
+
+	s.postTransfer(`{"source_account_id": 2, "destination_account_id": 1, "amount": {"value": "30.00", "currency": "BRL"}}`)
+	s.Equal(http.StatusCreated, s.recorder.Code)
+	s.JSONEq(`{
+		"transfer_id": 7,
+		"source_account_id": 2,
+		"destination_account_id": 1,
+		"amount": {"value": "30.00", "currency": "BRL"},
+		"debit": {"transaction_id": 20, "account_id": 2, "operation_type_id": 5, "amount": {"value": "-30.00", "currency": "BRL"},
+			"balance": {"value": "-30.00", "currency": "BRL"}, "status": "posted", "transfer_id": 7,
+			"event_date": "2025-02-14T11:00:00Z", "created_at": "2025-02-14T11:00:00Z", "updated_at": "2025-02-14T11:00:00Z"},
+		"credit": {"transaction_id": 21, "account_id": 1, "operation_type_id": 6, "amount": {"value": "30.00", "currency": "BRL"},
+			"balance": {"value": "20.00", "currency": "BRL"}, "status": "posted", "transfer_id": 7,
+			"event_date": "2025-02-14T11:00:00Z", "created_at": "2025-02-14T11:00:00Z", "updated_at": "2025-02-14T11:00:00Z"},
+		"created_at": "2025-02-14T11:00:00Z"
+	}`, s.recorder.Body.String())
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateTransferSuite) TestCreateTransferInvalidFields() {
+	s.postTransfer(`{"source_account_id": 0, "destination_account_id": 1, "amount": {"value": "-30.00", "currency": "BRL"}}`)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"source_account_id","message":"Must be at least 1."}`)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"amount.value","message":"Must be greater than zero."}`)
+
+	// Each field error is detailed with its own message, in field order
+	var resp writer.ErrorResponse
+	s.Require().NoError(json.Unmarshal(s.recorder.Body.Bytes(), &resp))
+	s.Require().Len(resp.Errors, 2)
+	s.Equal("Must be greater than zero.", resp.Errors[0].Detail)
+	s.Equal("Must be at least 1.", resp.Errors[1].Detail)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateTransferSuite) TestCreateTransferSameAccount() {
+	s.postTransfer(`{"source_account_id": 1, "destination_account_id": 1, "amount": {"value": "30.00", "currency": "BRL"}}`)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"destination_account_id","message":"Must be different from the source account."}`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testCreateTransferSuite) TestCreateTransferAccountIDNotFound() {
+	s.dataRepo.Mock.On("CreateTransfer", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrAccountIDNotExists)
+
+	s.postTransfer(`{"source_account_id": 2, "destination_account_id": 1, "amount": {"value": "30.00", "currency": "BRL"}}`)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (422)
+func (s *testCreateTransferSuite) TestCreateTransferInsufficientCreditLimit() {
+	s.dataRepo.Mock.On("CreateTransfer", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrInsufficientCreditLimit)
+
+	s.postTransfer(`{"source_account_id": 2, "destination_account_id": 1, "amount": {"value": "30.00", "currency": "BRL"}}`)
+	s.Equal(http.StatusUnprocessableEntity, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"code":"insufficient_credit_limit"`)
+}
+
+// @Failed testcase - statusCode (422)
+func (s *testCreateTransferSuite) TestCreateTransferAccountClosed() {
+	s.dataRepo.Mock.On("CreateTransfer", mock.Anything, mock.Anything).
+		Return(nil, repository.ErrAccountClosed)
+
+	s.postTransfer(`{"source_account_id": 2, "destination_account_id": 1, "amount": {"value": "30.00", "currency": "BRL"}}`)
+	s.Equal(http.StatusUnprocessableEntity, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"code":"account_closed"`)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testCreateTransferSuite) TestCreateTransferInternalServerError() {
+	s.dataRepo.Mock.On("CreateTransfer", mock.Anything, mock.Anything).
+		Return(nil, errors.New("something went wrong"))
+
+	s.postTransfer(`{"source_account_id": 2, "destination_account_id": 1, "amount": {"value": "30.00", "currency": "BRL"}}`)
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
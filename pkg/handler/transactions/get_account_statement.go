@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/validator"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"go.uber.org/zap"
+)
+
+// GetAccountStatement streams the statement of an account, with the opening balance, the transactions of the
+// period ordered by event date with the running balance after each of them, and the closing balance.
+// Supported query params are from and to (RFC3339 event_date range) and format (csv, json or ndjson).
+// The statement is streamed from the database as it's read, so an error half way through can only
+// cut the response short, leaving it without its closing balance.
+func (h *transactionsHandler) GetAccountStatement(w http.ResponseWriter, r *http.Request) {
+	// path : "app/v1/accounts/{id}/statement"
+	path := r.URL.Path
+	parts := strings.Split(path, "/")
+	accountIDStr := parts[4]
+
+	// Get accountID from request URL
+	accountID, err := strconv.Atoi(accountIDStr)
+	if err != nil {
+		logger.Log.Error("Failed to get query param from request URL", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title:  "Invalid Account ID",
+				Code:   writer.ErrCodeInvalidRequest,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Parse and validate the period and format
+	req, format, validationErrs := parseGetAccountStatementRequest(accountID, r)
+	if validationErrs != nil {
+		logger.Log.Error("Validation failed for GetAccountStatement request", zap.String("validation_errors", validationErrs.Error()))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title: writer.ErrTilteValidationFailed,
+				Code:  writer.ErrCodeInvalidRequest,
+			},
+			validationErrs.FieldErrors()...,
+		)
+		return
+	}
+
+	// Stream the statement, the response starts with the opening balance
+	statementWriter := newStatementWriter(w, accountID, format)
+	err = h.DataRepo.StreamStatement(r.Context(), req, statementWriter)
+	if err == nil {
+		return
+	}
+
+	if statementWriter.started() {
+		logger.Log.Error("Failed to stream the statement for GetAccountStatement request", zap.Error(err))
+		return
+	}
+
+	logger.Log.Error("Database call failed for GetAccountStatement request", zap.Error(err))
+	// Return 404 error if data not found
+	if errors.Is(err, sql.ErrNoRows) {
+		writer.WriteJSONError(
+			w,
+			http.StatusNotFound,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleDataNotFound,
+				Code:   writer.ErrCodeDataNotFound,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Return 500 internal server error for other errors
+	writer.WriteJSONError(
+		w,
+		http.StatusInternalServerError,
+		writer.ErrorDescription{
+			Title:  writer.ErrTitleUnexpectedError,
+			Code:   writer.ErrCodeUnexpectedError,
+			Detail: err.Error(),
+		},
+	)
+}
+
+// parseGetAccountStatementRequest parses and validates the query params for GetAccountStatement API handler.
+func parseGetAccountStatementRequest(accountID int, r *http.Request) (repository.StatementReqParams, string, *validator.ValidationErrors) {
+	errors := validator.NewValidationErrors()
+	query := r.URL.Query()
+
+	req := repository.StatementReqParams{
+		AccountID: accountID,
+	}
+
+	if v := query.Get("from"); v != "" {
+		from, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			errors.Add("from", "From must be a RFC3339 timestamp.")
+		} else {
+			req.From = &from
+		}
+	}
+
+	if v := query.Get("to"); v != "" {
+		to, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			errors.Add("to", "To must be a RFC3339 timestamp.")
+		} else {
+			req.To = &to
+		}
+	}
+
+	if req.From != nil && req.To != nil && !req.From.Before(*req.To) {
+		errors.Add("to", "To must be after from.")
+	}
+
+	format := query.Get("format")
+	if format == "" {
+		format = StatementFormatJSON
+	}
+	validator.Field(errors, "format", format, validator.Enum(StatementFormatCSV, StatementFormatJSON, StatementFormatNDJSON))
+
+	if len(errors.Errors) > 0 {
+		return req, format, errors
+	}
+
+	return req, format, nil
+}
@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// testGetAccountStatementSuite is a test suite object to test GetAccountStatement API handler.
+type testGetAccountStatementSuite struct {
+	suite.Suite
+
+	dataRepo   *mocks.DataRepo
+	router     *chi.Mux
+	trxHandler TransactionsHandler
+	recorder   *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testGetAccountStatementSuite.
+func (s *testGetAccountStatementSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.trxHandler = NewTransactionsHandler(s.dataRepo, testConfig)
+
+	s.router = chi.NewRouter()
+	s.router.Get("/app/v1/accounts/{id}/statement", s.trxHandler.GetAccountStatement)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestGetAccountStatementSuite is the custom test suite runner for GetAccountStatement API handler.
+func TestGetAccountStatementSuite(t *testing.T) {
+	suite.Run(t, new(testGetAccountStatementSuite))
+}
+
+// streamStatement returns a mock run function streaming a statement with a debit and a credit.
+func streamStatement(args mock.Arguments) {
+	w := args.Get(2).(repository.StatementWriter)
+	eventDate := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	w.WriteOpening(repository.Money{Amount: 10000, Currency: "BRL"})
+	w.WriteLine(repository.StatementLine{
+		Transaction:    repository.TransactionResponse{TransactionID: 1, AccountID: 1, OperationTypeID: 1, Amount: -5000, Currency: "BRL", EventDate: eventDate},
+		RunningBalance: repository.Money{Amount: 5000, Currency: "BRL"},
+	})
+	w.WriteLine(repository.StatementLine{
+		Transaction:    repository.TransactionResponse{TransactionID: 2, AccountID: 1, OperationTypeID: 4, Amount: 2000, Currency: "BRL", EventDate: eventDate},
+		RunningBalance: repository.Money{Amount: 7000, Currency: "BRL"},
+	})
+	w.WriteClosing(repository.Money{Amount: 7000, Currency: "BRL"})
+}
+
+// @Success testcase - statusCode (200)
+func (s *testGetAccountStatementSuite) TestGetAccountStatementJSON() {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.dataRepo.Mock.On("StreamStatement", mock.Anything, repository.StatementReqParams{AccountID: 1, From: &from}, mock.Anything).
+		Run(streamStatement).Return(nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/accounts/1/statement?from=2025-01-01T00:00:00Z", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.Equal("application/json; charset=utf-8", s.recorder.Header().Get("Content-Type"))
+
+	var resp struct {
+		AccountID      int                     `json:"account_id"`
+		OpeningBalance repository.Money        `json:"opening_balance"`
+		Transactions   []StatementLineResponse `json:"transactions"`
+		ClosingBalance repository.Money        `json:"closing_balance"`
+	}
+	s.Require().NoError(json.Unmarshal(s.recorder.Body.Bytes(), &resp))
+	s.Equal(1, resp.AccountID)
+	s.Equal(repository.Money{Amount: 10000, Currency: "BRL"}, resp.OpeningBalance)
+	s.Require().Len(resp.Transactions, 2)
+	s.Equal(repository.Money{Amount: 5000, Currency: "BRL"}, resp.Transactions[0].RunningBalance)
+	s.Equal(repository.Money{Amount: 7000, Currency: "BRL"}, resp.ClosingBalance)
+}
+
+// @Success testcase - statusCode (200)
+func (s *testGetAccountStatementSuite) TestGetAccountStatementCSV() {
+	s.dataRepo.Mock.On("StreamStatement", mock.Anything, repository.StatementReqParams{AccountID: 1}, mock.Anything).
+		Run(streamStatement).Return(nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/accounts/1/statement?format=csv", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.Equal("text/csv; charset=utf-8", s.recorder.Header().Get("Content-Type"))
+	s.Equal(
+		"record_type,transaction_id,event_date,operation_type_id,amount,running_balance,currency\n"+
+			"opening_balance,,,,,100.00,BRL\n"+
+			"transaction,1,2025-01-15T10:00:00Z,1,-50.00,50.00,BRL\n"+
+			"transaction,2,2025-01-15T10:00:00Z,4,20.00,70.00,BRL\n"+
+			"closing_balance,,,,,70.00,BRL\n",
+		s.recorder.Body.String(),
+	)
+}
+
+// @Success testcase - statusCode (200)
+func (s *testGetAccountStatementSuite) TestGetAccountStatementNDJSON() {
+	s.dataRepo.Mock.On("StreamStatement", mock.Anything, repository.StatementReqParams{AccountID: 1}, mock.Anything).
+		Run(streamStatement).Return(nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/accounts/1/statement?format=ndjson", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.Equal("application/x-ndjson", s.recorder.Header().Get("Content-Type"))
+
+	lines := strings.Split(strings.TrimSpace(s.recorder.Body.String()), "\n")
+	s.Require().Len(lines, 4)
+	s.Equal(`{"type":"opening_balance","balance":{"value":"100.00","currency":"BRL"}}`, lines[0])
+	s.Contains(lines[1], `{"type":"transaction","transaction_id":1,`)
+	s.Contains(lines[1], `"running_balance":{"value":"50.00","currency":"BRL"}`)
+	s.Equal(`{"type":"closing_balance","balance":{"value":"70.00","currency":"BRL"}}`, lines[3])
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testGetAccountStatementSuite) TestGetAccountStatementInvalidRequest() {
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/accounts/1/statement?format=pdf&from=yesterday", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"format","message":"Must be one of csv, json, ndjson."}`)
+	s.Contains(s.recorder.Body.String(), `"source":{"field":"from","message":"From must be a RFC3339 timestamp."}`)
+}
+
+// @Failed testcase - statusCode (404)
+func (s *testGetAccountStatementSuite) TestGetAccountStatementNotFound() {
+	s.dataRepo.Mock.On("StreamStatement", mock.Anything, mock.Anything, mock.Anything).Return(sql.ErrNoRows)
+
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/accounts/1/statement", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusNotFound, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testGetAccountStatementSuite) TestGetAccountStatementInternalServerError() {
+	s.dataRepo.Mock.On("StreamStatement", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("something went wrong"))
+
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/accounts/1/statement", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (200)
+func (s *testGetAccountStatementSuite) TestGetAccountStatementStreamError() {
+	s.dataRepo.Mock.On("StreamStatement", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(repository.StatementWriter).WriteOpening(repository.Money{Amount: 10000, Currency: "BRL"})
+		}).Return(errors.New("something went wrong"))
+
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/accounts/1/statement", nil)
+
+	// The response has started, so it's cut short without its closing balance
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.NotContains(s.recorder.Body.String(), "closing_balance")
+}
+
+// @Success testcase - statusCode (200)
+func (s *testGetAccountStatementSuite) TestGetAccountStatementFlushesLines() {
+	s.dataRepo.Mock.On("StreamStatement", mock.Anything, repository.StatementReqParams{AccountID: 1}, mock.Anything).
+		Run(func(args mock.Arguments) {
+			w := args.Get(2).(repository.StatementWriter)
+			w.WriteOpening(repository.Money{Amount: 10000, Currency: "BRL"})
+			for i := 1; i <= statementFlushLines; i++ {
+				w.WriteLine(repository.StatementLine{
+					Transaction:    repository.TransactionResponse{TransactionID: i, AccountID: 1, OperationTypeID: 4, Amount: 100, Currency: "BRL"},
+					RunningBalance: repository.Money{Amount: 10000 + int64(i)*100, Currency: "BRL"},
+				})
+			}
+
+			// The lines are sent to the client before the statement is closed
+			s.True(s.recorder.Flushed)
+			s.Contains(s.recorder.Body.String(), "transaction,100,")
+			w.WriteClosing(repository.Money{Amount: 20000, Currency: "BRL"})
+		}).Return(nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/accounts/1/statement?format=csv", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), "closing_balance,,,,,200.00,BRL\n")
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	getTransactionByIDEndpoint = "/app/v1/transactions/%d"
+)
+
+// testGetTransactionByIDSuite is a test suite object to test GetTransactionByID API handler.
+type testGetTransactionByIDSuite struct {
+	suite.Suite
+
+	dataRepo   *mocks.DataRepo
+	router     *chi.Mux
+	trxHandler TransactionsHandler
+	recorder   *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testGetTransactionByIDSuite.
+func (s *testGetTransactionByIDSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.trxHandler = NewTransactionsHandler(s.dataRepo, testConfig)
+
+	s.router = chi.NewRouter()
+	s.router.Get("/app/v1/transactions/{id}", s.trxHandler.GetTransactionByID)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestGetTransactionByIDSuite is the custom test suite runner for GetTransactionByID API handler.
+func TestGetTransactionByIDSuite(t *testing.T) {
+	suite.Run(t, new(testGetTransactionByIDSuite))
+}
+
+// @Success testcase - statusCode (200)
+func (s *testGetTransactionByIDSuite) TestGetTransactionByIDSuccess() {
+	transactionID := 1
+	t := time.Date(2025, time.February, 10, 12, 30, 0, 0, time.UTC)
+	s.dataRepo.Mock.On("GetTransactionByID", mock.Anything, transactionID).
+		Return(&repository.TransactionDetailsResponse{
+			TransactionResponse: repository.TransactionResponse{
+				TransactionID:   transactionID,
+				AccountID:       1,
+				OperationTypeID: 1,
+				Amount:          -5000,
+				Currency:        "BRL",
+				Balance:         -5000,
+				Status:          repository.TransactionStatusPosted,
+				EventDate:       t,
+				CreatedAt:       t,
+				UpdatedAt:       t,
+			},
+			OperationTypeDescription: "Normal Purchase",
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf(getTransactionByIDEndpoint, transactionID), nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"amount":{"value":"-50.00","currency":"BRL"}`)
+	s.Contains(s.recorder.Body.String(), `"event_date":"2025-02-10T12:30:00Z"`)
+	s.Contains(s.recorder.Body.String(), `"operation_type":{"operation_type_id":1,"description":"Normal Purchase"}`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testGetTransactionByIDSuite) TestGetTransactionByIDInvalidTransactionID() {
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/transactions/abc", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (404)
+func (s *testGetTransactionByIDSuite) TestGetTransactionByIDNotFound() {
+	s.dataRepo.Mock.On("GetTransactionByID", mock.Anything, 1).
+		Return(nil, sql.ErrNoRows)
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf(getTransactionByIDEndpoint, 1), nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusNotFound, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testGetTransactionByIDSuite) TestGetTransactionByIDInternalServerError() {
+	s.dataRepo.Mock.On("GetTransactionByID", mock.Anything, 1).
+		Return(nil, errors.New("something went wrong"))
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf(getTransactionByIDEndpoint, 1), nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
 )
@@ -9,16 +10,38 @@ import (
 // TransactionsHandler is an interface providing methods for transactions-related API requests.
 type TransactionsHandler interface {
 	CreateTransaction(w http.ResponseWriter, r *http.Request)
+	CreateTransactionsBatch(w http.ResponseWriter, r *http.Request)
+	GetTransactionByID(w http.ResponseWriter, r *http.Request)
+	ListAccountTransactions(w http.ResponseWriter, r *http.Request)
+	GetAccountStatement(w http.ResponseWriter, r *http.Request)
+	ListTransactionInstallments(w http.ResponseWriter, r *http.Request)
+	ReverseTransaction(w http.ResponseWriter, r *http.Request)
+	CreateTransfer(w http.ResponseWriter, r *http.Request)
+	CreateHold(w http.ResponseWriter, r *http.Request)
+	CaptureHold(w http.ResponseWriter, r *http.Request)
+	VoidHold(w http.ResponseWriter, r *http.Request)
+}
+
+// Config holds the configurable rules of the transactions API.
+type Config struct {
+	// EventDateBackdateWindow is how far in the past a client supplied event date may be.
+	EventDateBackdateWindow time.Duration
+	// EventDateFutureSkew is how far in the future a client supplied event date may be.
+	EventDateFutureSkew time.Duration
+	// HoldTTL is how long a hold reserves the credit of an account before it expires.
+	HoldTTL time.Duration
 }
 
 // transactionsHandler object.
 type transactionsHandler struct {
 	DataRepo repository.DataRepo
+	Config   Config
 }
 
 // NewTransactionsHandler initializes and returns a new TransactionsHandler.
-func NewTransactionsHandler(dataRepo repository.DataRepo) TransactionsHandler {
+func NewTransactionsHandler(dataRepo repository.DataRepo, config Config) TransactionsHandler {
 	return &transactionsHandler{
 		DataRepo: dataRepo,
+		Config:   config,
 	}
 }
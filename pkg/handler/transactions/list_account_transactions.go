@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/validator"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 1000
+)
+
+// ListAccountTransactions lists the transactions of an account, ordered by transaction ID.
+// Supported query params are operation_type_id, from and to (RFC3339 event_date range), cursor and limit.
+func (h *transactionsHandler) ListAccountTransactions(w http.ResponseWriter, r *http.Request) {
+	// path : "app/v1/accounts/{id}/transactions"
+	path := r.URL.Path
+	parts := strings.Split(path, "/")
+	accountIDStr := parts[4]
+
+	// Get accountID from request URL
+	accountID, err := strconv.Atoi(accountIDStr)
+	if err != nil {
+		logger.Log.Error("Failed to get query param from request URL", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title:  "Invalid Account ID",
+				Code:   writer.ErrCodeInvalidRequest,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Parse and validate the filters
+	req, validationErrs := parseListAccountTransactionsRequest(accountID, r)
+	if validationErrs != nil {
+		logger.Log.Error("Validation failed for ListAccountTransactions request", zap.String("validation_errors", validationErrs.Error()))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title: writer.ErrTilteValidationFailed,
+				Code:  writer.ErrCodeInvalidRequest,
+			},
+			validationErrs.FieldErrors()...,
+		)
+		return
+	}
+
+	// Get the transactions page
+	dbResp, err := h.DataRepo.ListTransactions(r.Context(), req)
+	if err != nil {
+		logger.Log.Error("Database call failed for ListAccountTransactions request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Send success response
+	resp := TransactionsPageResponse{
+		Transactions: make([]TransactionResponse, 0, len(dbResp.Transactions)),
+		NextCursor:   dbResp.NextCursor,
+	}
+	for i := range dbResp.Transactions {
+		resp.Transactions = append(resp.Transactions, newTransactionResponse(&dbResp.Transactions[i]))
+	}
+	if err := writer.WriteJSON(w, http.StatusOK, resp); err != nil {
+		logger.Log.Error("Error writting success response for ListAccountTransactions request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+}
+
+// parseListAccountTransactionsRequest parses and validates the query params for ListAccountTransactions API handler.
+func parseListAccountTransactionsRequest(accountID int, r *http.Request) (repository.ListTransactionsReqParams, *validator.ValidationErrors) {
+	errors := validator.NewValidationErrors()
+	query := r.URL.Query()
+
+	req := repository.ListTransactionsReqParams{
+		AccountID: accountID,
+		Limit:     defaultPageLimit,
+	}
+
+	if v := query.Get("operation_type_id"); v != "" {
+		operationTypeID, err := strconv.Atoi(v)
+		if err != nil || operationTypeID <= 0 {
+			errors.Add("operation_type_id", "Operation type ID must be a positive integer.")
+		} else {
+			req.OperationTypeID = &operationTypeID
+		}
+	}
+
+	if v := query.Get("from"); v != "" {
+		from, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			errors.Add("from", "From must be a RFC3339 timestamp.")
+		} else {
+			req.EventDateFrom = &from
+		}
+	}
+
+	if v := query.Get("to"); v != "" {
+		to, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			errors.Add("to", "To must be a RFC3339 timestamp.")
+		} else {
+			req.EventDateTo = &to
+		}
+	}
+
+	if req.EventDateFrom != nil && req.EventDateTo != nil && !req.EventDateFrom.Before(*req.EventDateTo) {
+		errors.Add("to", "To must be after from.")
+	}
+
+	if v := query.Get("cursor"); v != "" {
+		cursor, err := strconv.Atoi(v)
+		if err != nil || cursor < 0 {
+			errors.Add("cursor", "Cursor must be a non-negative integer.")
+		} else {
+			req.Cursor = cursor
+		}
+	}
+
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > maxPageLimit {
+			errors.Add("limit", "Limit must be between 1 and 1000.")
+		} else {
+			req.Limit = limit
+		}
+	}
+
+	if len(errors.Errors) > 0 {
+		return req, errors
+	}
+
+	return req, nil
+}
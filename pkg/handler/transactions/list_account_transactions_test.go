@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// testListAccountTransactionsSuite is a test suite object to test ListAccountTransactions API handler.
+type testListAccountTransactionsSuite struct {
+	suite.Suite
+
+	dataRepo   *mocks.DataRepo
+	router     *chi.Mux
+	trxHandler TransactionsHandler
+	recorder   *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testListAccountTransactionsSuite.
+func (s *testListAccountTransactionsSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.trxHandler = NewTransactionsHandler(s.dataRepo, testConfig)
+
+	s.router = chi.NewRouter()
+	s.router.Get("/app/v1/accounts/{id}/transactions", s.trxHandler.ListAccountTransactions)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestListAccountTransactionsSuite is the custom test suite runner for ListAccountTransactions API handler.
+func TestListAccountTransactionsSuite(t *testing.T) {
+	suite.Run(t, new(testListAccountTransactionsSuite))
+}
+
+// @Success testcase - statusCode (200)
+func (s *testListAccountTransactionsSuite) TestListAccountTransactionsSuccess() {
+	t := time.Now()
+	operationTypeID := 4
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	nextCursor := 10
+
+	s.dataRepo.Mock.On("ListTransactions", mock.Anything, repository.ListTransactionsReqParams{
+		AccountID:       1,
+		OperationTypeID: &operationTypeID,
+		EventDateFrom:   &from,
+		Cursor:          9,
+		Limit:           1,
+	}).Return(&repository.TransactionsPage{
+		Transactions: []repository.TransactionResponse{
+			{
+				TransactionID:   10,
+				AccountID:       1,
+				OperationTypeID: operationTypeID,
+				Amount:          5000,
+				Currency:        "BRL",
+				EventDate:       t,
+				CreatedAt:       t,
+				UpdatedAt:       t,
+			},
+		},
+		NextCursor: &nextCursor,
+	}, nil)
+
+	endpoint := "/app/v1/accounts/1/transactions?operation_type_id=4&from=2025-01-01T00:00:00Z&cursor=9&limit=1"
+	req := httptest.NewRequest(http.MethodGet, endpoint, nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"next_cursor":10`)
+}
+
+// @Success testcase - statusCode (200)
+func (s *testListAccountTransactionsSuite) TestListAccountTransactionsDefaultLimit() {
+	s.dataRepo.Mock.On("ListTransactions", mock.Anything, repository.ListTransactionsReqParams{
+		AccountID: 1,
+		Limit:     defaultPageLimit,
+	}).Return(&repository.TransactionsPage{
+		Transactions: []repository.TransactionResponse{},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/accounts/1/transactions", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.JSONEq(`{"transactions":[],"next_cursor":null}`, s.recorder.Body.String())
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testListAccountTransactionsSuite) TestListAccountTransactionsInvalidAccountID() {
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/accounts/abc/transactions", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testListAccountTransactionsSuite) TestListAccountTransactionsInvalidFilters() {
+	endpoint := "/app/v1/accounts/1/transactions?from=yesterday&limit=5000"
+	req := httptest.NewRequest(http.MethodGet, endpoint, nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testListAccountTransactionsSuite) TestListAccountTransactionsInternalServerError() {
+	s.dataRepo.Mock.On("ListTransactions", mock.Anything, mock.Anything).
+		Return(nil, errors.New("something went wrong"))
+
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/accounts/1/transactions", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"go.uber.org/zap"
+)
+
+// ListTransactionInstallments lists the installment schedule of a transaction with the status of each installment.
+func (h *transactionsHandler) ListTransactionInstallments(w http.ResponseWriter, r *http.Request) {
+	// path : "app/v1/transactions/{id}/installments"
+	path := r.URL.Path
+	parts := strings.Split(path, "/")
+	transactionIDStr := parts[4]
+
+	// Get transactionID from request URL
+	transactionID, err := strconv.Atoi(transactionIDStr)
+	if err != nil {
+		logger.Log.Error("Failed to get query param from request URL", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title:  "Invalid Transaction ID",
+				Code:   writer.ErrCodeInvalidRequest,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Get the installments of the transaction
+	dbResp, err := h.DataRepo.ListInstallments(r.Context(), transactionID)
+	if err != nil {
+		logger.Log.Error("Database call failed for ListTransactionInstallments request", zap.Error(err))
+		// Return 404 error if data not found
+		if errors.Is(err, sql.ErrNoRows) {
+			writer.WriteJSONError(
+				w,
+				http.StatusNotFound,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleDataNotFound,
+					Code:   writer.ErrCodeDataNotFound,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		// Return 500 internal server error for other errors
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Send success response
+	resp := InstallmentsResponse{
+		TransactionID: transactionID,
+		Installments:  make([]InstallmentResponse, 0, len(dbResp)),
+	}
+	for i := range dbResp {
+		resp.Installments = append(resp.Installments, newInstallmentResponse(&dbResp[i]))
+	}
+	if err := writer.WriteJSON(w, http.StatusOK, resp); err != nil {
+		logger.Log.Error("Error writting success response for ListTransactionInstallments request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	listTransactionInstallmentsEndpoint = "/app/v1/transactions/%d/installments"
+)
+
+// testListTransactionInstallmentsSuite is a test suite object to test ListTransactionInstallments API handler.
+type testListTransactionInstallmentsSuite struct {
+	suite.Suite
+
+	dataRepo   *mocks.DataRepo
+	router     *chi.Mux
+	trxHandler TransactionsHandler
+	recorder   *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testListTransactionInstallmentsSuite.
+func (s *testListTransactionInstallmentsSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.trxHandler = NewTransactionsHandler(s.dataRepo, testConfig)
+
+	s.router = chi.NewRouter()
+	s.router.Get("/app/v1/transactions/{id}/installments", s.trxHandler.ListTransactionInstallments)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestListTransactionInstallmentsSuite is the custom test suite runner for ListTransactionInstallments API handler.
+func TestListTransactionInstallmentsSuite(t *testing.T) {
+	suite.Run(t, new(testListTransactionInstallmentsSuite))
+}
+
+// @Success testcase - statusCode (200)
+func (s *testListTransactionInstallmentsSuite) TestListTransactionInstallmentsSuccess() {
+	transactionID := 1
+	dueDate := time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)
+	s.dataRepo.Mock.On("ListInstallments", mock.Anything, transactionID).
+		Return([]repository.InstallmentResponse{
+			{
+				TransactionID: transactionID,
+				Number:        1,
+				DueDate:       dueDate,
+				Amount:        -3335,
+				Currency:      "BRL",
+				Status:        repository.InstallmentStatusPaid,
+			},
+			{
+				TransactionID: transactionID,
+				Number:        2,
+				DueDate:       dueDate.AddDate(0, 1, 0),
+				Amount:        -3333,
+				Currency:      "BRL",
+				Status:        repository.InstallmentStatusUnpaid,
+			},
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf(listTransactionInstallmentsEndpoint, transactionID), nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `{"number":1,"due_date":"2025-02-28","amount":{"value":"-33.35","currency":"BRL"},"status":"paid"}`)
+	s.Contains(s.recorder.Body.String(), `"status":"unpaid"`)
+}
+
+// @Success testcase - statusCode (200)
+func (s *testListTransactionInstallmentsSuite) TestListTransactionInstallmentsEmpty() {
+	s.dataRepo.Mock.On("ListInstallments", mock.Anything, 1).
+		Return([]repository.InstallmentResponse{}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf(listTransactionInstallmentsEndpoint, 1), nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"installments":[]`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testListTransactionInstallmentsSuite) TestListTransactionInstallmentsInvalidTransactionID() {
+	req := httptest.NewRequest(http.MethodGet, "/app/v1/transactions/abc/installments", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (404)
+func (s *testListTransactionInstallmentsSuite) TestListTransactionInstallmentsNotFound() {
+	s.dataRepo.Mock.On("ListInstallments", mock.Anything, 1).
+		Return(nil, sql.ErrNoRows)
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf(listTransactionInstallmentsEndpoint, 1), nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusNotFound, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testListTransactionInstallmentsSuite) TestListTransactionInstallmentsInternalServerError() {
+	s.dataRepo.Mock.On("ListInstallments", mock.Anything, 1).
+		Return(nil, errors.New("something went wrong"))
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf(listTransactionInstallmentsEndpoint, 1), nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"go.uber.org/zap"
+)
+
+// ReverseTransaction handles the reversal of a transaction, returning the reversal and the reversed original.
+func (h *transactionsHandler) ReverseTransaction(w http.ResponseWriter, r *http.Request) {
+	// path : "app/v1/transactions/{id}/reversal"
+	path := r.URL.Path
+	parts := strings.Split(path, "/")
+	transactionIDStr := parts[4]
+
+	// Get transactionID from request URL
+	transactionID, err := strconv.Atoi(transactionIDStr)
+	if err != nil {
+		logger.Log.Error("Failed to get query param from request URL", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title:  "Invalid Transaction ID",
+				Code:   writer.ErrCodeInvalidRequest,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Reverse the transaction
+	dbResp, err := h.DataRepo.ReverseTransaction(r.Context(), transactionID)
+	if err != nil {
+		logger.Log.Error("Database call failed for ReverseTransaction request", zap.Error(err))
+		// Return 404 error if data not found
+		if errors.Is(err, sql.ErrNoRows) {
+			writer.WriteJSONError(
+				w,
+				http.StatusNotFound,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleDataNotFound,
+					Code:   writer.ErrCodeDataNotFound,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		// Return 409 conflict error if the transaction is already reversed
+		if errors.Is(err, repository.ErrTransactionAlreadyReversed) {
+			writer.WriteJSONError(
+				w,
+				http.StatusConflict,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleAlreadyReversed,
+					Code:   writer.ErrCodeAlreadyReversed,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		// Return 422 error if the transaction can't be reversed in its current state
+		if errors.Is(err, repository.ErrTransactionSettled) ||
+			errors.Is(err, repository.ErrReversalNotReversible) ||
+			errors.Is(err, repository.ErrTransferNotReversible) {
+			writer.WriteJSONError(
+				w,
+				http.StatusUnprocessableEntity,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleNotReversible,
+					Code:   writer.ErrCodeNotReversible,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		if errors.Is(err, repository.ErrInsufficientCreditLimit) {
+			writer.WriteJSONError(
+				w,
+				http.StatusUnprocessableEntity,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleCreditLimit,
+					Code:   writer.ErrCodeCreditLimit,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		// Return 500 internal server error for other errors
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Send success response
+	resp := ReversalResponse{
+		Reversal: newTransactionResponse(&dbResp.Reversal),
+		Original: newTransactionResponse(&dbResp.Original),
+	}
+	w.Header().Set("Location", transactionLocation(resp.Reversal.TransactionID))
+	if err := writer.WriteJSON(w, http.StatusCreated, resp); err != nil {
+		logger.Log.Error("Error writting success response for ReverseTransaction request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+}
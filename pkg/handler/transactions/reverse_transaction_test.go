@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	reverseTransactionEndpoint = "/app/v1/transactions/%d/reversal"
+)
+
+// testReverseTransactionSuite is a test suite object to test ReverseTransaction API handler.
+type testReverseTransactionSuite struct {
+	suite.Suite
+
+	dataRepo   *mocks.DataRepo
+	router     *chi.Mux
+	trxHandler TransactionsHandler
+	recorder   *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testReverseTransactionSuite.
+func (s *testReverseTransactionSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.trxHandler = NewTransactionsHandler(s.dataRepo, testConfig)
+
+	s.router = chi.NewRouter()
+	s.router.Post("/app/v1/transactions/{id}/reversal", s.trxHandler.ReverseTransaction)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestReverseTransactionSuite is the custom test suite runner for ReverseTransaction API handler.
+func TestReverseTransactionSuite(t *testing.T) {
+	suite.Run(t, new(testReverseTransactionSuite))
+}
+
+// @Success testcase - statusCode (201)
+func (s *testReverseTransactionSuite) TestReverseTransactionSuccess() {
+	transactionID := 1
+	t := time.Now()
+	s.dataRepo.Mock.On("ReverseTransaction", mock.Anything, transactionID).
+		Return(&repository.ReversalResponse{
+			Reversal: repository.TransactionResponse{
+				TransactionID:         2,
+				AccountID:             1,
+				OperationTypeID:       1,
+				Amount:                5000,
+				Currency:              "BRL",
+				Status:                repository.TransactionStatusPosted,
+				ReversesTransactionID: &transactionID,
+				EventDate:             t,
+				CreatedAt:             t,
+				UpdatedAt:             t,
+			},
+			Original: repository.TransactionResponse{
+				TransactionID:   transactionID,
+				AccountID:       1,
+				OperationTypeID: 1,
+				Amount:          -5000,
+				Currency:        "BRL",
+				Status:          repository.TransactionStatusReversed,
+				EventDate:       t,
+				CreatedAt:       t,
+				UpdatedAt:       t,
+			},
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf(reverseTransactionEndpoint, transactionID), nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusCreated, s.recorder.Code)
+	s.Equal("/app/v1/transactions/2", s.recorder.Header().Get("Location"))
+	s.Contains(s.recorder.Body.String(), `"reverses_transaction_id":1`)
+	s.Contains(s.recorder.Body.String(), `"status":"reversed"`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testReverseTransactionSuite) TestReverseTransactionInvalidTransactionID() {
+	req := httptest.NewRequest(http.MethodPost, "/app/v1/transactions/abc/reversal", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (404)
+func (s *testReverseTransactionSuite) TestReverseTransactionNotFound() {
+	s.dataRepo.Mock.On("ReverseTransaction", mock.Anything, 1).
+		Return(nil, sql.ErrNoRows)
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf(reverseTransactionEndpoint, 1), nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusNotFound, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (409)
+func (s *testReverseTransactionSuite) TestReverseTransactionAlreadyReversed() {
+	s.dataRepo.Mock.On("ReverseTransaction", mock.Anything, 1).
+		Return(nil, repository.ErrTransactionAlreadyReversed)
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf(reverseTransactionEndpoint, 1), nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusConflict, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (422)
+func (s *testReverseTransactionSuite) TestReverseTransactionSettled() {
+	s.dataRepo.Mock.On("ReverseTransaction", mock.Anything, 1).
+		Return(nil, repository.ErrTransactionSettled)
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf(reverseTransactionEndpoint, 1), nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusUnprocessableEntity, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (422)
+func (s *testReverseTransactionSuite) TestReverseTransactionTransfer() {
+	s.dataRepo.Mock.On("ReverseTransaction", mock.Anything, 1).
+		Return(nil, repository.ErrTransferNotReversible)
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf(reverseTransactionEndpoint, 1), nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusUnprocessableEntity, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"code":"not_reversible"`)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testReverseTransactionSuite) TestReverseTransactionInternalServerError() {
+	s.dataRepo.Mock.On("ReverseTransaction", mock.Anything, 1).
+		Return(nil, errors.New("something went wrong"))
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf(reverseTransactionEndpoint, 1), nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
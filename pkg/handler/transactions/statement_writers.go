@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+)
+
+// Formats of GetAccountStatement API.
+const (
+	StatementFormatCSV    = "csv"
+	StatementFormatJSON   = "json"
+	StatementFormatNDJSON = "ndjson"
+)
+
+// Types of the records of a CSV or NDJSON statement.
+const (
+	statementRecordOpening     = "opening_balance"
+	statementRecordTransaction = "transaction"
+	statementRecordClosing     = "closing_balance"
+)
+
+// statementFlushLines is the number of transaction lines after which the streamed statement is sent to the client,
+// so a long statement isn't held in the buffers until it's closed.
+const statementFlushLines = 100
+
+// statementCSVHeader is the header row of a CSV statement.
+var statementCSVHeader = []string{"record_type", "transaction_id", "event_date", "operation_type_id", "amount", "running_balance", "currency"}
+
+// startableStatementWriter is a repository.StatementWriter which tells whether the response has started.
+type startableStatementWriter interface {
+	repository.StatementWriter
+	started() bool
+}
+
+// newStatementWriter returns the writer streaming a statement in the given format to the response.
+func newStatementWriter(w http.ResponseWriter, accountID int, format string) startableStatementWriter {
+	stream := statementStream{w: w}
+	switch format {
+	case StatementFormatCSV:
+		return &csvStatementWriter{statementStream: stream}
+	case StatementFormatNDJSON:
+		return &ndjsonStatementWriter{statementStream: stream}
+	default:
+		return &jsonStatementWriter{statementStream: stream, accountID: accountID}
+	}
+}
+
+// statementStream is the streamed response of a statement, started with the opening balance.
+type statementStream struct {
+	w      http.ResponseWriter
+	stream *writer.StreamWriter
+	lines  int
+}
+
+// start writes the header of the statement response with the given content type.
+func (s *statementStream) start(contentType string) error {
+	stream, err := writer.NewStreamWriter(s.w, http.StatusOK, contentType)
+	if err != nil {
+		return err
+	}
+
+	s.stream = stream
+	return nil
+}
+
+// started tells whether the statement response has started.
+func (s *statementStream) started() bool {
+	return s.stream != nil
+}
+
+// lineWritten counts a written transaction line, and tells whether the statement should be flushed.
+func (s *statementStream) lineWritten() bool {
+	s.lines++
+	return s.lines%statementFlushLines == 0
+}
+
+// csvStatementWriter streams a statement as CSV, with a record per balance and transaction.
+type csvStatementWriter struct {
+	statementStream
+	csv *csv.Writer
+}
+
+func (c *csvStatementWriter) WriteOpening(balance repository.Money) error {
+	if err := c.start("text/csv; charset=utf-8"); err != nil {
+		return err
+	}
+
+	c.csv = csv.NewWriter(c.stream)
+	if err := c.csv.Write(statementCSVHeader); err != nil {
+		return err
+	}
+	return c.csv.Write([]string{statementRecordOpening, "", "", "", "", balance.String(), balance.Currency})
+}
+
+func (c *csvStatementWriter) WriteLine(line repository.StatementLine) error {
+	trx := line.Transaction
+	err := c.csv.Write([]string{
+		statementRecordTransaction,
+		strconv.Itoa(trx.TransactionID),
+		trx.EventDate.Format(time.RFC3339),
+		strconv.Itoa(trx.OperationTypeID),
+		trx.Money().String(),
+		line.RunningBalance.String(),
+		trx.Currency,
+	})
+	if err != nil || !c.lineWritten() {
+		return err
+	}
+	return c.flush()
+}
+
+func (c *csvStatementWriter) WriteClosing(balance repository.Money) error {
+	if err := c.csv.Write([]string{statementRecordClosing, "", "", "", "", balance.String(), balance.Currency}); err != nil {
+		return err
+	}
+
+	return c.flush()
+}
+
+// flush sends the records buffered by the CSV writer to the client.
+func (c *csvStatementWriter) flush() error {
+	c.csv.Flush()
+	if err := c.csv.Error(); err != nil {
+		return err
+	}
+
+	c.stream.Flush()
+	return nil
+}
+
+// ndjsonStatementWriter streams a statement as NDJSON, with a record per balance and transaction.
+type ndjsonStatementWriter struct {
+	statementStream
+}
+
+func (n *ndjsonStatementWriter) WriteOpening(balance repository.Money) error {
+	if err := n.start("application/x-ndjson"); err != nil {
+		return err
+	}
+
+	return n.stream.WriteJSON(StatementRecordResponse{Type: statementRecordOpening, Balance: &balance})
+}
+
+func (n *ndjsonStatementWriter) WriteLine(line repository.StatementLine) error {
+	lineResp := newStatementLineResponse(line)
+	if err := n.stream.WriteJSON(StatementRecordResponse{Type: statementRecordTransaction, StatementLineResponse: &lineResp}); err != nil {
+		return err
+	}
+
+	if n.lineWritten() {
+		n.stream.Flush()
+	}
+	return nil
+}
+
+func (n *ndjsonStatementWriter) WriteClosing(balance repository.Money) error {
+	if err := n.stream.WriteJSON(StatementRecordResponse{Type: statementRecordClosing, Balance: &balance}); err != nil {
+		return err
+	}
+
+	n.stream.Flush()
+	return nil
+}
+
+// jsonStatementWriter streams a statement as a single JSON object, with the transactions in an array.
+type jsonStatementWriter struct {
+	statementStream
+	accountID int
+}
+
+func (j *jsonStatementWriter) WriteOpening(balance repository.Money) error {
+	if err := j.start("application/json; charset=utf-8"); err != nil {
+		return err
+	}
+
+	opening, err := json.Marshal(balance)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(j.stream, `{"account_id":%d,"opening_balance":%s,"transactions":[`, j.accountID, opening)
+	return err
+}
+
+func (j *jsonStatementWriter) WriteLine(line repository.StatementLine) error {
+	lineResp, err := json.Marshal(newStatementLineResponse(line))
+	if err != nil {
+		return err
+	}
+
+	if j.lines > 0 {
+		lineResp = append([]byte{','}, lineResp...)
+	}
+	if _, err := j.stream.Write(lineResp); err != nil {
+		return err
+	}
+
+	if j.lineWritten() {
+		j.stream.Flush()
+	}
+	return nil
+}
+
+func (j *jsonStatementWriter) WriteClosing(balance repository.Money) error {
+	closing, err := json.Marshal(balance)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(j.stream, `],"closing_balance":%s}`+"\n", closing); err != nil {
+		return err
+	}
+
+	j.stream.Flush()
+	return nil
+}
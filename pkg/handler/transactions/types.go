@@ -1,15 +1,284 @@
 package handler
 
-const StatusSuccess = "success"
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header holding the client supplied idempotency key.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is the response header set when the original response is replayed.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+
+	maxInstallments = 48
+
+	maxBatchSize = 1000
+
+	// transactionLocationFormat is the format of the Location header for a created transaction.
+	transactionLocationFormat = "/app/v1/transactions/%d"
+)
 
 // CreateTrxReqParams is the request object for CreateTransaction API.
+// Installments splits a purchase with installments into a monthly schedule, and
+// EventDate is the RFC3339 time of the event, defaulting to the time it's posted.
 type CreateTrxReqParams struct {
-	AccountID       int     `json:"account_id"`
-	OperationTypeID int     `json:"operation_type_id"`
-	Amount          float64 `json:"amount"`
+	AccountID       int             `json:"account_id"`
+	OperationTypeID int             `json:"operation_type_id"`
+	Amount          AmountReqParams `json:"amount"`
+	Installments    int             `json:"installments,omitempty"`
+	EventDate       string          `json:"event_date,omitempty"`
+}
+
+// CreateTransferReqParams is the request object for CreateTransfer API.
+// Amount is the positive amount moved from the source account to the destination account.
+type CreateTransferReqParams struct {
+	SourceAccountID      int             `json:"source_account_id"`
+	DestinationAccountID int             `json:"destination_account_id"`
+	Amount               AmountReqParams `json:"amount"`
+}
+
+// CreateHoldReqParams is the request object for CreateHold API.
+// Amount is the positive amount held for a debit of the operation type.
+type CreateHoldReqParams struct {
+	AccountID       int             `json:"account_id"`
+	OperationTypeID int             `json:"operation_type_id"`
+	Amount          AmountReqParams `json:"amount"`
+}
+
+// CaptureHoldReqParams is the request object for CaptureHold API.
+// The whole held amount is captured when Amount is missing.
+type CaptureHoldReqParams struct {
+	Amount *AmountReqParams `json:"amount,omitempty"`
+}
+
+// Batch modes of CreateTransactionsBatch API.
+const (
+	// BatchModeAtomic creates either all the transactions of the batch or none.
+	BatchModeAtomic = "atomic"
+	// BatchModePartial creates each transaction of the batch unless it fails on its own.
+	BatchModePartial = "partial"
+)
+
+// CreateTrxBatchReqParams is the request object for CreateTransactionsBatch API.
+type CreateTrxBatchReqParams struct {
+	Mode         string               `json:"mode"`
+	Transactions []CreateTrxReqParams `json:"transactions"`
+}
+
+// AmountReqParams is the request object of an amount, with the value as a decimal string or number.
+// It's validated against its currency before being converted to repository.Money.
+type AmountReqParams struct {
+	Value    json.Number `json:"value"`
+	Currency string      `json:"currency"`
+}
+
+// idempotentRequest is the validated CreateTransaction request hashed to detect idempotency key reuse.
+type idempotentRequest struct {
+	AccountID       int              `json:"account_id"`
+	OperationTypeID int              `json:"operation_type_id"`
+	Amount          repository.Money `json:"amount"`
+	Installments    int              `json:"installments,omitempty"`
+	EventDate       string           `json:"event_date,omitempty"`
+}
+
+// TransactionResponse is the response object, which holds Transaction data.
+// Balance is the part of the amount not settled yet, and a reversal holds the transaction it reverses.
+type TransactionResponse struct {
+	TransactionID         int              `json:"transaction_id"`
+	AccountID             int              `json:"account_id"`
+	OperationTypeID       int              `json:"operation_type_id"`
+	Amount                repository.Money `json:"amount"`
+	Balance               repository.Money `json:"balance"`
+	Status                string           `json:"status"`
+	ReversesTransactionID *int             `json:"reverses_transaction_id,omitempty"`
+	TransferID            *int             `json:"transfer_id,omitempty"`
+	EventDate             string           `json:"event_date"`
+	CreatedAt             string           `json:"created_at"`
+	UpdatedAt             string           `json:"updated_at"`
+}
+
+// OperationTypeResponse is the response object, which holds the operation type of a Transaction.
+type OperationTypeResponse struct {
+	OperationTypeID int    `json:"operation_type_id"`
+	Description     string `json:"description"`
+}
+
+// TransactionDetailsResponse is the response object for GetTransactionByID API.
+type TransactionDetailsResponse struct {
+	TransactionResponse
+	OperationType OperationTypeResponse `json:"operation_type"`
+}
+
+// ReversalResponse is the response object for ReverseTransaction API.
+type ReversalResponse struct {
+	Reversal TransactionResponse `json:"reversal"`
+	Original TransactionResponse `json:"original"`
+}
+
+// TransferResponse is the response object for CreateTransfer API, with the debit on the source account
+// and the credit on the destination account.
+type TransferResponse struct {
+	TransferID           int                 `json:"transfer_id"`
+	SourceAccountID      int                 `json:"source_account_id"`
+	DestinationAccountID int                 `json:"destination_account_id"`
+	Amount               repository.Money    `json:"amount"`
+	Debit                TransactionResponse `json:"debit"`
+	Credit               TransactionResponse `json:"credit"`
+	CreatedAt            string              `json:"created_at"`
+}
+
+// HoldResponse is the response object, which holds Hold data.
+// CapturedAmount is the part of the amount captured into the transaction with TransactionID.
+type HoldResponse struct {
+	HoldID          int              `json:"hold_id"`
+	AccountID       int              `json:"account_id"`
+	OperationTypeID int              `json:"operation_type_id"`
+	Amount          repository.Money `json:"amount"`
+	CapturedAmount  repository.Money `json:"captured_amount"`
+	Status          string           `json:"status"`
+	TransactionID   *int             `json:"transaction_id,omitempty"`
+	ExpiresAt       string           `json:"expires_at"`
+	CreatedAt       string           `json:"created_at"`
+	UpdatedAt       string           `json:"updated_at"`
+}
+
+// HoldCaptureResponse is the response object for CaptureHold API.
+type HoldCaptureResponse struct {
+	Hold        HoldResponse        `json:"hold"`
+	Transaction TransactionResponse `json:"transaction"`
+}
+
+// BatchResponse is the response object for CreateTransactionsBatch API.
+type BatchResponse struct {
+	Mode    string                `json:"mode"`
+	Results []BatchResultResponse `json:"results"`
+}
+
+// BatchResultResponse is the result of a transaction of a batch, keyed by its index in the batch.
+// Status is the status code of the transaction, with either the created transaction or its errors.
+type BatchResultResponse struct {
+	Index       int                       `json:"index"`
+	Status      int                       `json:"status"`
+	Transaction *TransactionResponse      `json:"transaction,omitempty"`
+	Errors      []writer.ErrorDescription `json:"errors,omitempty"`
+}
+
+// TransactionsPageResponse is the response object for ListAccountTransactions API.
+type TransactionsPageResponse struct {
+	Transactions []TransactionResponse `json:"transactions"`
+	NextCursor   *int                  `json:"next_cursor"`
+}
+
+// InstallmentResponse is the response object, which holds Installment data.
+type InstallmentResponse struct {
+	Number  int              `json:"number"`
+	DueDate string           `json:"due_date"`
+	Amount  repository.Money `json:"amount"`
+	Status  string           `json:"status"`
+}
+
+// InstallmentsResponse is the response object for ListTransactionInstallments API.
+type InstallmentsResponse struct {
+	TransactionID int                   `json:"transaction_id"`
+	Installments  []InstallmentResponse `json:"installments"`
+}
+
+// StatementLineResponse is a Transaction of an account statement, with the balance of the account after it.
+type StatementLineResponse struct {
+	TransactionResponse
+	RunningBalance repository.Money `json:"running_balance"`
+}
+
+// StatementRecordResponse is a record of an NDJSON statement, either a balance or a transaction line.
+type StatementRecordResponse struct {
+	Type    string            `json:"type"`
+	Balance *repository.Money `json:"balance,omitempty"`
+	*StatementLineResponse
+}
+
+// newTransactionResponse converts the Transaction data from database to the API response object.
+func newTransactionResponse(dbResp *repository.TransactionResponse) TransactionResponse {
+	return TransactionResponse{
+		TransactionID:         dbResp.TransactionID,
+		AccountID:             dbResp.AccountID,
+		OperationTypeID:       dbResp.OperationTypeID,
+		Amount:                dbResp.Money(),
+		Balance:               dbResp.UnsettledMoney(),
+		Status:                dbResp.Status,
+		ReversesTransactionID: dbResp.ReversesTransactionID,
+		TransferID:            dbResp.TransferID,
+		EventDate:             dbResp.EventDate.Format(time.RFC3339),
+		CreatedAt:             dbResp.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:             dbResp.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// newTransferResponse converts the Transfer data from database to the API response object.
+func newTransferResponse(dbResp *repository.TransferResponse) TransferResponse {
+	return TransferResponse{
+		TransferID:           dbResp.TransferID,
+		SourceAccountID:      dbResp.SourceAccountID,
+		DestinationAccountID: dbResp.DestinationAccountID,
+		Amount:               dbResp.Money(),
+		Debit:                newTransactionResponse(&dbResp.Debit),
+		Credit:               newTransactionResponse(&dbResp.Credit),
+		CreatedAt:            dbResp.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// newHoldResponse converts the Hold data from database to the API response object.
+func newHoldResponse(dbResp *repository.HoldResponse) HoldResponse {
+	return HoldResponse{
+		HoldID:          dbResp.HoldID,
+		AccountID:       dbResp.AccountID,
+		OperationTypeID: dbResp.OperationTypeID,
+		Amount:          dbResp.Money(),
+		CapturedAmount:  dbResp.CapturedMoney(),
+		Status:          dbResp.Status,
+		TransactionID:   dbResp.TransactionID,
+		ExpiresAt:       dbResp.ExpiresAt.Format(time.RFC3339),
+		CreatedAt:       dbResp.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       dbResp.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// transactionLocation returns the URL path of the given transaction.
+func transactionLocation(transactionID int) string {
+	return fmt.Sprintf(transactionLocationFormat, transactionID)
+}
+
+// newTransactionDetailsResponse converts the Transaction details from database to the API response object.
+func newTransactionDetailsResponse(dbResp *repository.TransactionDetailsResponse) TransactionDetailsResponse {
+	return TransactionDetailsResponse{
+		TransactionResponse: newTransactionResponse(&dbResp.TransactionResponse),
+		OperationType: OperationTypeResponse{
+			OperationTypeID: dbResp.OperationTypeID,
+			Description:     dbResp.OperationTypeDescription,
+		},
+	}
+}
+
+// newInstallmentResponse converts the Installment data from database to the API response object.
+func newInstallmentResponse(dbResp *repository.InstallmentResponse) InstallmentResponse {
+	return InstallmentResponse{
+		Number:  dbResp.Number,
+		DueDate: dbResp.DueDate.Format(time.DateOnly),
+		Amount:  dbResp.Money(),
+		Status:  dbResp.Status,
+	}
 }
 
-// CreateTrxResponse is the response object for CreateTransaction API.
-type CreateTrxResponse struct {
-	Status string `json:"status"`
+// newStatementLineResponse converts the statement line from database to the API response object.
+func newStatementLineResponse(line repository.StatementLine) StatementLineResponse {
+	return StatementLineResponse{
+		TransactionResponse: newTransactionResponse(&line.Transaction),
+		RunningBalance:      line.RunningBalance,
+	}
 }
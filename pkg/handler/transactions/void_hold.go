@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/writer"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"go.uber.org/zap"
+)
+
+// VoidHold handles the void of a hold, releasing the held amount without capturing it.
+func (h *transactionsHandler) VoidHold(w http.ResponseWriter, r *http.Request) {
+	// path : "app/v1/holds/{id}/void"
+	path := r.URL.Path
+	parts := strings.Split(path, "/")
+	holdIDStr := parts[4]
+
+	// Get holdID from request URL
+	holdID, err := strconv.Atoi(holdIDStr)
+	if err != nil {
+		logger.Log.Error("Failed to get query param from request URL", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusBadRequest,
+			writer.ErrorDescription{
+				Title:  "Invalid Hold ID",
+				Code:   writer.ErrCodeInvalidRequest,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Void the hold
+	dbResp, err := h.DataRepo.VoidHold(r.Context(), holdID)
+	if err != nil {
+		logger.Log.Error("Database call failed for VoidHold request", zap.Error(err))
+		// Return 404 error if data not found
+		if errors.Is(err, sql.ErrNoRows) {
+			writer.WriteJSONError(
+				w,
+				http.StatusNotFound,
+				writer.ErrorDescription{
+					Title:  writer.ErrTitleDataNotFound,
+					Code:   writer.ErrCodeDataNotFound,
+					Detail: err.Error(),
+				},
+			)
+			return
+		}
+
+		if errors.Is(err, repository.ErrHoldNotActive) {
+			statusCode, errDesc := holdStateError(err)
+			writer.WriteJSONError(w, statusCode, errDesc)
+			return
+		}
+
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+
+	// Send success response
+	if err := writer.WriteJSON(w, http.StatusOK, newHoldResponse(dbResp)); err != nil {
+		logger.Log.Error("Error writting success response for VoidHold request", zap.Error(err))
+		writer.WriteJSONError(
+			w,
+			http.StatusInternalServerError,
+			writer.ErrorDescription{
+				Title:  writer.ErrTitleUnexpectedError,
+				Code:   writer.ErrCodeUnexpectedError,
+				Detail: err.Error(),
+			},
+		)
+		return
+	}
+}
@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/logger"
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	voidHoldEndpoint = "/app/v1/holds/%d/void"
+)
+
+// testVoidHoldSuite is a test suite object to test VoidHold API handler.
+type testVoidHoldSuite struct {
+	suite.Suite
+
+	dataRepo   *mocks.DataRepo
+	router     *chi.Mux
+	trxHandler TransactionsHandler
+	recorder   *httptest.ResponseRecorder
+}
+
+// SetupTest setups and initializes the testVoidHoldSuite.
+func (s *testVoidHoldSuite) SetupTest() {
+	s.recorder = httptest.NewRecorder()
+	s.dataRepo = new(mocks.DataRepo)
+	s.trxHandler = NewTransactionsHandler(s.dataRepo, testConfig)
+
+	s.router = chi.NewRouter()
+	s.router.Post("/app/v1/holds/{id}/void", s.trxHandler.VoidHold)
+
+	if err := logger.InitLogger(); err != nil {
+		log.Fatalf("can't initialize zap logger: %v", err)
+		return
+	}
+	defer logger.SyncLogger()
+}
+
+// TestVoidHoldSuite is the custom test suite runner for VoidHold API handler.
+func TestVoidHoldSuite(t *testing.T) {
+	suite.Run(t, new(testVoidHoldSuite))
+}
+
+// @Success testcase - statusCode (200)
+func (s *testVoidHoldSuite) TestVoidHoldSuccess() {
+	voided := testHold()
+	voided.Status = repository.HoldStatusVoided
+	s.dataRepo.Mock.On("VoidHold", mock.Anything, 5).Return(voided, nil)
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf(voidHoldEndpoint, 5), nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"status":"voided"`)
+	s.NotContains(s.recorder.Body.String(), `"transaction_id"`)
+}
+
+// @Failed testcase - statusCode (400)
+func (s *testVoidHoldSuite) TestVoidHoldInvalidHoldID() {
+	req := httptest.NewRequest(http.MethodPost, "/app/v1/holds/abc/void", nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusBadRequest, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (404)
+func (s *testVoidHoldSuite) TestVoidHoldNotFound() {
+	s.dataRepo.Mock.On("VoidHold", mock.Anything, 5).Return(nil, sql.ErrNoRows)
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf(voidHoldEndpoint, 5), nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusNotFound, s.recorder.Code)
+}
+
+// @Failed testcase - statusCode (409)
+func (s *testVoidHoldSuite) TestVoidHoldNotActive() {
+	s.dataRepo.Mock.On("VoidHold", mock.Anything, 5).Return(nil, repository.ErrHoldNotActive)
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf(voidHoldEndpoint, 5), nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusConflict, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"code":"hold_not_active"`)
+}
+
+// @Failed testcase - statusCode (500)
+func (s *testVoidHoldSuite) TestVoidHoldInternalServerError() {
+	s.dataRepo.Mock.On("VoidHold", mock.Anything, 5).Return(nil, errors.New("something went wrong"))
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf(voidHoldEndpoint, 5), nil)
+
+	s.router.ServeHTTP(s.recorder, req)
+	s.Equal(http.StatusInternalServerError, s.recorder.Code)
+}
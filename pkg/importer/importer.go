@@ -0,0 +1,149 @@
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	trxHandler "github.com/aswinudhayakumar/account-transactions/pkg/handler/transactions"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+)
+
+// DefaultBatchSize is the default number of rows loaded with a single repository call.
+const DefaultBatchSize = 500
+
+// ErrInvalidBatchSize is returned when the batch size is not positive.
+var ErrInvalidBatchSize = errors.New("batch size must be a positive integer")
+
+// Importer loads the transactions of import files in batches. The rows are validated with the same
+// rules as the CreateTransaction API, and the rejected rows are written to the Rejects writer.
+type Importer struct {
+	DataRepo  repository.DataRepo
+	Config    trxHandler.Config
+	BatchSize int
+	Rejects   io.Writer
+}
+
+// Summary holds the outcome of an import.
+type Summary struct {
+	Rows     int
+	Imported int
+	Rejected int
+}
+
+// String returns a human readable summary of the import.
+func (s Summary) String() string {
+	return fmt.Sprintf("%d rows read, %d imported, %d rejected", s.Rows, s.Imported, s.Rejected)
+}
+
+// RejectedRow is a row of an import file which wasn't imported, written to the rejects as a JSON line.
+type RejectedRow struct {
+	Line   int      `json:"line"`
+	Record string   `json:"record"`
+	Errors []string `json:"errors"`
+}
+
+// pendingRow is a valid row waiting to be loaded with its batch.
+type pendingRow struct {
+	row Row
+	req repository.CreateTransactionReqParams
+}
+
+// Import reads all the rows and loads the valid ones in batches, each batch committed on its own.
+// The import stops at the first error reading the file or loading a batch, the batches loaded
+// before the error stay imported and are counted in the returned summary.
+func (im *Importer) Import(ctx context.Context, rows RowReader) (Summary, error) {
+	var summary Summary
+	if im.BatchSize <= 0 {
+		return summary, ErrInvalidBatchSize
+	}
+
+	rejects := json.NewEncoder(im.Rejects)
+	reject := func(row Row, errs ...string) error {
+		summary.Rejected++
+		return rejects.Encode(RejectedRow{
+			Line:   row.Line,
+			Record: row.Record,
+			Errors: errs,
+		})
+	}
+
+	pending := make([]pendingRow, 0, im.BatchSize)
+	flush := func() error {
+		if len(pending) == 0 {
+			return nil
+		}
+
+		batch := repository.CreateTransactionsReqParams{
+			Transactions: make([]repository.CreateTransactionReqParams, len(pending)),
+		}
+		for i, p := range pending {
+			batch.Transactions[i] = p.req
+		}
+
+		results, err := im.DataRepo.CreateTransactions(ctx, batch)
+		if err != nil {
+			return fmt.Errorf("failed to load the batch ending at line %d: %w", pending[len(pending)-1].row.Line, err)
+		}
+
+		for i, result := range results {
+			if result.Err != nil {
+				if err := reject(pending[i].row, result.Err.Error()); err != nil {
+					return err
+				}
+				continue
+			}
+			summary.Imported++
+		}
+
+		pending = pending[:0]
+		return nil
+	}
+
+	for {
+		row, err := rows.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return summary, fmt.Errorf("failed to read the file: %w", err)
+		}
+		summary.Rows++
+
+		if row.Err != nil {
+			if err := reject(row, row.Err.Error()); err != nil {
+				return summary, err
+			}
+			continue
+		}
+
+		// The event dates are checked against the time each row is read, as for an API request
+		req, validationErrs := trxHandler.ParseCreateTransactionRequest(row.Req, im.Config, time.Now())
+		if validationErrs != nil {
+			var errs []string
+			for _, fieldErr := range validationErrs.FieldErrors() {
+				errs = append(errs, fmt.Sprintf("%s: %s", fieldErr.Field, fieldErr.Message))
+			}
+			if err := reject(row, errs...); err != nil {
+				return summary, err
+			}
+			continue
+		}
+
+		pending = append(pending, pendingRow{row: row, req: req})
+		if len(pending) == im.BatchSize {
+			if err := flush(); err != nil {
+				return summary, err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return summary, err
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,111 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/mocks"
+	trxHandler "github.com/aswinudhayakumar/account-transactions/pkg/handler/transactions"
+	"github.com/aswinudhayakumar/account-transactions/pkg/repository"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// testImporterSuite is a test suite object to test the Importer.
+type testImporterSuite struct {
+	suite.Suite
+
+	dataRepo *mocks.DataRepo
+	rejects  *bytes.Buffer
+	importer *Importer
+}
+
+// SetupTest setups and initializes the testImporterSuite.
+func (s *testImporterSuite) SetupTest() {
+	s.dataRepo = new(mocks.DataRepo)
+	s.rejects = new(bytes.Buffer)
+	s.importer = &Importer{
+		DataRepo: s.dataRepo,
+		Config: trxHandler.Config{
+			EventDateBackdateWindow: 30 * 24 * time.Hour,
+			EventDateFutureSkew:     5 * time.Minute,
+		},
+		BatchSize: 2,
+		Rejects:   s.rejects,
+	}
+}
+
+// TestImporterSuite is the custom test suite runner for the Importer.
+func TestImporterSuite(t *testing.T) {
+	suite.Run(t, new(testImporterSuite))
+}
+
+// debitReq returns the repository request of a debit of the given amount.
+func debitReq(amount int64) repository.CreateTransactionReqParams {
+	return repository.CreateTransactionReqParams{
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          repository.Money{Amount: amount, Currency: "BRL"},
+	}
+}
+
+// @Success testcase
+func (s *testImporterSuite) TestImport() {
+	file := "account_id,operation_type_id,amount,currency\n" +
+		"1,1,10,BRL\n" +
+		"1,1,0,BRL\n" +
+		"1,1,20,BRL\n" +
+		"1,1,30,BRL\n"
+
+	// The valid rows are loaded in batches of two
+	s.dataRepo.Mock.On("CreateTransactions", mock.Anything, repository.CreateTransactionsReqParams{
+		Transactions: []repository.CreateTransactionReqParams{debitReq(1000), debitReq(2000)},
+	}).Return([]repository.BatchTransactionResult{
+		{Transaction: &repository.TransactionResponse{TransactionID: 1}},
+		{Err: repository.ErrInsufficientCreditLimit},
+	}, nil).Once()
+	s.dataRepo.Mock.On("CreateTransactions", mock.Anything, repository.CreateTransactionsReqParams{
+		Transactions: []repository.CreateTransactionReqParams{debitReq(3000)},
+	}).Return([]repository.BatchTransactionResult{
+		{Transaction: &repository.TransactionResponse{TransactionID: 2}},
+	}, nil).Once()
+
+	rows, err := NewRowReader(strings.NewReader(file), FormatCSV)
+	s.Require().NoError(err)
+
+	summary, err := s.importer.Import(context.Background(), rows)
+	s.Require().NoError(err)
+	s.Equal(Summary{Rows: 4, Imported: 2, Rejected: 2}, summary)
+	s.Equal(
+		`{"line":3,"record":"1,1,0,BRL","errors":["amount.value: Must not be zero."]}`+"\n"+
+			`{"line":4,"record":"1,1,20,BRL","errors":["transaction amount exceeds the available credit limit"]}`+"\n",
+		s.rejects.String(),
+	)
+	s.dataRepo.AssertExpectations(s.T())
+}
+
+// @Failed testcase
+func (s *testImporterSuite) TestImportError() {
+	file := `{"account_id": 1, "operation_type_id": 1, "amount": {"value": "10", "currency": "BRL"}}` + "\n"
+
+	s.dataRepo.Mock.On("CreateTransactions", mock.Anything, mock.Anything).Return(nil, errors.New("something went wrong"))
+
+	rows, err := NewRowReader(strings.NewReader(file), FormatNDJSON)
+	s.Require().NoError(err)
+
+	summary, err := s.importer.Import(context.Background(), rows)
+	s.Require().Error(err)
+	s.Equal(Summary{Rows: 1}, summary)
+}
+
+// @Failed testcase
+func (s *testImporterSuite) TestImportInvalidBatchSize() {
+	s.importer.BatchSize = 0
+
+	_, err := s.importer.Import(context.Background(), nil)
+	s.ErrorIs(err, ErrInvalidBatchSize)
+}
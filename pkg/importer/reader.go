@@ -0,0 +1,205 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	trxHandler "github.com/aswinudhayakumar/account-transactions/pkg/handler/transactions"
+)
+
+// File formats of transaction imports.
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+)
+
+// requiredCSVColumns are the columns a CSV transactions file must have, besides the optional
+// installments and event_date columns.
+var requiredCSVColumns = []string{"account_id", "operation_type_id", "amount", "currency"}
+
+// Errors
+var (
+	ErrUnsupportedFormat = errors.New("file format must be csv or ndjson")
+	ErrMissingColumn     = errors.New("CSV header is missing a required column")
+)
+
+// Row is a transaction row read from an import file. Err is set when the row can't be parsed.
+type Row struct {
+	Line   int
+	Record string
+	Req    trxHandler.CreateTrxReqParams
+	Err    error
+}
+
+// RowReader reads the transaction rows of an import file one by one, returning io.EOF at the end of the file.
+type RowReader interface {
+	Next() (Row, error)
+}
+
+// NewRowReader returns a RowReader streaming the rows of a file in the given format.
+func NewRowReader(r io.Reader, format string) (RowReader, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVReader(r)
+	case FormatNDJSON:
+		return newNDJSONReader(r), nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// csvReader reads transaction rows from a CSV file with a header row.
+type csvReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+// newCSVReader reads the header of the CSV file and returns its reader.
+func newCSVReader(r io.Reader) (*csvReader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.TrimSpace(column)] = i
+	}
+	for _, column := range requiredCSVColumns {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrMissingColumn, column)
+		}
+	}
+
+	return &csvReader{
+		reader:  reader,
+		columns: columns,
+	}, nil
+}
+
+// Next returns the next row of the CSV file.
+func (c *csvReader) Next() (Row, error) {
+	record, err := c.reader.Read()
+	if err != nil {
+		// A malformed record is rejected, the reader carries on with the next one
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return Row{Line: parseErr.StartLine, Err: fmt.Errorf("malformed CSV record: %w", parseErr.Err)}, nil
+		}
+		return Row{}, err
+	}
+
+	line, _ := c.reader.FieldPos(0)
+	row := Row{
+		Line:   line,
+		Record: encodeCSVRecord(record),
+	}
+
+	field := func(column string) string {
+		if i, ok := c.columns[column]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	row.Req.AccountID, row.Err = parseIntColumn("account_id", field("account_id"))
+	if row.Err != nil {
+		return row, nil
+	}
+	row.Req.OperationTypeID, row.Err = parseIntColumn("operation_type_id", field("operation_type_id"))
+	if row.Err != nil {
+		return row, nil
+	}
+	row.Req.Installments, row.Err = parseIntColumn("installments", field("installments"))
+	if row.Err != nil {
+		return row, nil
+	}
+	row.Req.Amount = trxHandler.AmountReqParams{
+		Value:    json.Number(field("amount")),
+		Currency: field("currency"),
+	}
+	row.Req.EventDate = field("event_date")
+
+	return row, nil
+}
+
+// parseIntColumn parses the integer value of a CSV column, an empty value is zero.
+func parseIntColumn(column, value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be an integer", column)
+	}
+	return n, nil
+}
+
+// encodeCSVRecord encodes the record as a CSV line, without the line break.
+func encodeCSVRecord(record []string) string {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write(record)
+	w.Flush()
+
+	return strings.TrimRight(buf.String(), "\r\n")
+}
+
+// ndjsonReader reads transaction rows from a newline delimited JSON file, one object per line.
+type ndjsonReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+// newNDJSONReader returns the reader of the NDJSON file.
+func newNDJSONReader(r io.Reader) *ndjsonReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+
+	return &ndjsonReader{
+		scanner: scanner,
+	}
+}
+
+// Next returns the next row of the NDJSON file, skipping blank lines.
+func (n *ndjsonReader) Next() (Row, error) {
+	for n.scanner.Scan() {
+		n.line++
+		record := strings.TrimSpace(n.scanner.Text())
+		if record == "" {
+			continue
+		}
+
+		row := Row{
+			Line:   n.line,
+			Record: record,
+		}
+
+		// Rows are decoded as strictly as the HTTP request bodies
+		decoder := json.NewDecoder(strings.NewReader(record))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&row.Req); err != nil {
+			row.Err = fmt.Errorf("malformed JSON object: %w", err)
+		} else if decoder.More() {
+			row.Err = errors.New("malformed JSON object: the line must hold a single JSON object")
+		}
+		return row, nil
+	}
+
+	if err := n.scanner.Err(); err != nil {
+		return Row{}, err
+	}
+	return Row{}, io.EOF
+}
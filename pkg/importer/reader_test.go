@@ -0,0 +1,97 @@
+package importer
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	trxHandler "github.com/aswinudhayakumar/account-transactions/pkg/handler/transactions"
+	"github.com/stretchr/testify/suite"
+)
+
+// testRowReaderSuite is a test suite object to test the readers of import files.
+type testRowReaderSuite struct {
+	suite.Suite
+}
+
+// TestRowReaderSuite is the custom test suite runner for the readers of import files.
+func TestRowReaderSuite(t *testing.T) {
+	suite.Run(t, new(testRowReaderSuite))
+}
+
+// readAll reads all the rows of the reader.
+func (s *testRowReaderSuite) readAll(reader RowReader) []Row {
+	var rows []Row
+	for {
+		row, err := reader.Next()
+		if err == io.EOF {
+			return rows
+		}
+		s.Require().NoError(err)
+		rows = append(rows, row)
+	}
+}
+
+// @Success testcase
+func (s *testRowReaderSuite) TestCSVReader() {
+	file := "account_id,operation_type_id,amount,currency,installments,event_date\n" +
+		"1,1,100.50,BRL,,\n" +
+		"1,2,300,BRL,3,2025-01-31T10:00:00Z\n" +
+		"abc,1,10,BRL,,\n"
+
+	reader, err := NewRowReader(strings.NewReader(file), FormatCSV)
+	s.Require().NoError(err)
+
+	rows := s.readAll(reader)
+	s.Require().Len(rows, 3)
+
+	s.Equal(2, rows[0].Line)
+	s.Equal("1,1,100.50,BRL,,", rows[0].Record)
+	s.NoError(rows[0].Err)
+	s.Equal(trxHandler.CreateTrxReqParams{
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          trxHandler.AmountReqParams{Value: json.Number("100.50"), Currency: "BRL"},
+	}, rows[0].Req)
+
+	s.Equal(3, rows[1].Line)
+	s.Equal(3, rows[1].Req.Installments)
+	s.Equal("2025-01-31T10:00:00Z", rows[1].Req.EventDate)
+
+	s.Equal(4, rows[2].Line)
+	s.EqualError(rows[2].Err, "account_id must be an integer")
+}
+
+// @Failed testcase
+func (s *testRowReaderSuite) TestCSVReaderMissingColumn() {
+	_, err := NewRowReader(strings.NewReader("account_id,operation_type_id,amount\n"), FormatCSV)
+	s.ErrorIs(err, ErrMissingColumn)
+}
+
+// @Success testcase
+func (s *testRowReaderSuite) TestNDJSONReader() {
+	file := `{"account_id": 1, "operation_type_id": 1, "amount": {"value": "100.50", "currency": "BRL"}}` + "\n" +
+		"\n" +
+		`{"account_id": 1, "operation_type_id": 1, "ammount": {"value": "10", "currency": "BRL"}}` + "\n"
+
+	reader, err := NewRowReader(strings.NewReader(file), FormatNDJSON)
+	s.Require().NoError(err)
+
+	rows := s.readAll(reader)
+	s.Require().Len(rows, 2)
+
+	s.Equal(1, rows[0].Line)
+	s.NoError(rows[0].Err)
+	s.Equal(json.Number("100.50"), rows[0].Req.Amount.Value)
+
+	// Blank lines are skipped and unknown fields are rejected
+	s.Equal(3, rows[1].Line)
+	s.ErrorContains(rows[1].Err, `unknown field "ammount"`)
+}
+
+// @Failed testcase
+func (s *testRowReaderSuite) TestUnsupportedFormat() {
+	_, err := NewRowReader(strings.NewReader(""), "xlsx")
+	s.ErrorIs(err, ErrUnsupportedFormat)
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Account statuses. Transactions can only be posted to active accounts.
+const (
+	AccountStatusActive  = "active"
+	AccountStatusBlocked = "blocked"
+	AccountStatusClosed  = "closed"
+)
+
+// accountStatusTransitions holds the statuses each account status can be changed to.
+// A blocked account can be reactivated, while closing an account is final.
+var accountStatusTransitions = map[string][]string{
+	AccountStatusActive:  {AccountStatusBlocked, AccountStatusClosed},
+	AccountStatusBlocked: {AccountStatusActive, AccountStatusClosed},
+	AccountStatusClosed:  {},
+}
+
+const (
+	updateAccountStatusQuery = `
+	UPDATE accounts SET
+		status = $2,
+		updated_at = CURRENT_TIMESTAMP
+	WHERE account_id=$1
+	RETURNING account_id, document_number, currency, balance, credit_limit, available_credit_limit, status, created_at, updated_at;
+	`
+
+	createAccountStatusHistoryQuery = `INSERT INTO account_status_history (account_id, from_status, to_status, reason) VALUES ($1, $2, $3, $4);`
+)
+
+// UpdateAccountStatus changes the status of the Account, recording the change with its reason
+// in the status history of the account, and returns the updated row.
+// Setting the current status again changes nothing, and ErrAccountStatusTransition is returned
+// for a change the account status doesn't allow. sql.ErrNoRows is returned when the account doesn't exist.
+func (dr *dataRepo) UpdateAccountStatus(ctx context.Context, req UpdateAccountStatusReqParams) (*AccountResponse, error) {
+	var res AccountResponse
+	err := dr.execTxn(ctx, func(tx *sqlx.Tx, audit *auditTrail) error {
+		// Lock the account so that transactions can't be posted while its status is changing
+		account, err := lockAccount(ctx, tx, req.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if account.Status == req.Status {
+			res = *account
+			return nil
+		}
+
+		if !canTransitionAccountStatus(account.Status, req.Status) {
+			return ErrAccountStatusTransition
+		}
+
+		if err := tx.GetContext(
+			ctx,
+			&res,
+			updateAccountStatusQuery,
+			req.AccountID,
+			req.Status,
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createAccountStatusHistoryQuery,
+			req.AccountID,
+			account.Status,
+			req.Status,
+			req.Reason,
+		); err != nil {
+			return err
+		}
+
+		audit.record(AuditEntityAccount, res.AccountID, AuditActionStatusChanged, *account, res)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// canTransitionAccountStatus reports whether an account status can be changed from one status to the other.
+func canTransitionAccountStatus(from, to string) bool {
+	for _, status := range accountStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// checkAccountStatus returns the error a transaction posted to an account with the given status fails with,
+// nil for an active account.
+func checkAccountStatus(status string) error {
+	switch status {
+	case AccountStatusBlocked:
+		return ErrAccountBlocked
+	case AccountStatusClosed:
+		return ErrAccountClosed
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/suite"
+)
+
+// testAccountStatusesSuite is a test suite object to test the account status changes.
+type testAccountStatusesSuite struct {
+	suite.Suite
+
+	db   *sqlx.DB
+	mock sqlmock.Sqlmock
+	repo DataRepo
+}
+
+// SetupTest setups and initializes the testAccountStatusesSuite.
+func (s *testAccountStatusesSuite) SetupTest() {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	s.Require().NoError(err)
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+
+	s.db = sqlxDB
+	s.mock = mock
+	s.repo = NewDataRepo(sqlxDB)
+}
+
+// TearDownTest gracefully closes the test suite, by closing the db connection.
+func (s *testAccountStatusesSuite) TearDownTest() {
+	if s.db != nil {
+		err := s.db.Close()
+		if err != nil {
+			return
+		}
+	}
+}
+
+// TestAccountStatusesSuite is the custom test suite to test the account status changes.
+func TestAccountStatusesSuite(t *testing.T) {
+	suite.Run(t, new(testAccountStatusesSuite))
+}
+
+// @Success testcase
+func (s *testAccountStatusesSuite) TestUpdateAccountStatusSuccess() {
+	req := UpdateAccountStatusReqParams{
+		AccountID: 1,
+		Status:    AccountStatusBlocked,
+		Reason:    "Suspected fraud",
+	}
+
+	t := time.Now()
+	expected := &AccountResponse{
+		AccountID:      req.AccountID,
+		DocumentNumber: "1234567",
+		Currency:       "BRL",
+		Status:         AccountStatusBlocked,
+		CreatedAt:      t,
+		UpdatedAt:      t,
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID, DocumentNumber: "1234567", Currency: "BRL", Status: AccountStatusActive}))
+
+	s.mock.ExpectQuery(updateAccountStatusQuery).
+		WithArgs(req.AccountID, req.Status).
+		WillReturnRows(accountRows(expected))
+
+	s.mock.ExpectExec(createAccountStatusHistoryQuery).
+		WithArgs(req.AccountID, AccountStatusActive, AccountStatusBlocked, req.Reason).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	expectAuditEntries(s.mock, []string{AuditEntityAccount}, []int64{1}, []string{AuditActionStatusChanged})
+
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.UpdateAccountStatus(context.Background(), req)
+	s.Require().NoError(err)
+
+	s.Equal(expected, actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Success testcase
+func (s *testAccountStatusesSuite) TestUpdateAccountStatusUnchanged() {
+	req := UpdateAccountStatusReqParams{
+		AccountID: 1,
+		Status:    AccountStatusBlocked,
+		Reason:    "Suspected fraud",
+	}
+
+	t := time.Now()
+	expected := &AccountResponse{
+		AccountID:      req.AccountID,
+		DocumentNumber: "1234567",
+		Currency:       "BRL",
+		Status:         AccountStatusBlocked,
+		CreatedAt:      t,
+		UpdatedAt:      t,
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnRows(accountRows(expected))
+
+	// Nothing changes, so nothing is recorded in the status history
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.UpdateAccountStatus(context.Background(), req)
+	s.Require().NoError(err)
+
+	s.Equal(expected, actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testAccountStatusesSuite) TestUpdateAccountStatusClosedAccount() {
+	req := UpdateAccountStatusReqParams{
+		AccountID: 1,
+		Status:    AccountStatusActive,
+		Reason:    "Customer request",
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID, DocumentNumber: "1234567", Currency: "BRL", Status: AccountStatusClosed}))
+
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.UpdateAccountStatus(context.Background(), req)
+	s.Require().ErrorIs(err, ErrAccountStatusTransition)
+
+	s.Require().Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testAccountStatusesSuite) TestUpdateAccountStatusNoRowsError() {
+	req := UpdateAccountStatusReqParams{
+		AccountID: 1,
+		Status:    AccountStatusClosed,
+		Reason:    "Customer request",
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnError(sql.ErrNoRows)
+
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.UpdateAccountStatus(context.Background(), req)
+	s.Require().ErrorIs(err, sql.ErrNoRows)
+
+	s.Require().Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testAccountStatusesSuite) TestUpdateAccountStatusError() {
+	req := UpdateAccountStatusReqParams{
+		AccountID: 1,
+		Status:    AccountStatusClosed,
+		Reason:    "Customer request",
+	}
+
+	t := time.Now()
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID, DocumentNumber: "1234567", Currency: "BRL", Status: AccountStatusActive}))
+
+	s.mock.ExpectQuery(updateAccountStatusQuery).
+		WithArgs(req.AccountID, req.Status).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID, Status: AccountStatusClosed, CreatedAt: t, UpdatedAt: t}))
+
+	s.mock.ExpectExec(createAccountStatusHistoryQuery).
+		WithArgs(req.AccountID, AccountStatusActive, AccountStatusClosed, req.Reason).
+		WillReturnError(errors.New("something went wrong"))
+
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.UpdateAccountStatus(context.Background(), req)
+	s.Require().Error(err)
+
+	s.Require().Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
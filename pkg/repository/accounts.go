@@ -2,26 +2,80 @@ package repository
 
 import (
 	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
 )
 
 const (
-	createAccountQuery = `INSERT INTO accounts (document_number) VALUES ($1);`
+	// accountsDocumentNumberKey is the unique index on the document number of accounts
+	accountsDocumentNumberKey = "accounts_document_number_key"
+
+	createAccountQuery = `INSERT INTO accounts (document_number, currency, credit_limit, available_credit_limit) VALUES ($1, $2, $3, $3)
+	RETURNING account_id, document_number, currency, balance, credit_limit, available_credit_limit, status, created_at, updated_at;`
+
+	getAccountByAccountIDQuery = `SELECT account_id, document_number, currency, balance, credit_limit, available_credit_limit, status, created_at, updated_at FROM accounts WHERE account_id=$1;`
+
+	getAccountByDocumentNumberQuery = `SELECT account_id, document_number, currency, balance, credit_limit, available_credit_limit, status, created_at, updated_at FROM accounts WHERE document_number=$1;`
+
+	lockAccountQuery = `SELECT account_id, document_number, currency, balance, credit_limit, available_credit_limit, status, created_at, updated_at FROM accounts WHERE account_id=$1 FOR UPDATE;`
 
-	getAccountByAccountIDQuery = `SELECT account_id, document_number, created_at, updated_at FROM accounts WHERE account_id=$1;`
+	// The available credit limit moves by the change of the credit limit, keeping the credit in use
+	updateAccountQuery = `
+	UPDATE accounts SET
+		credit_limit = COALESCE($2, credit_limit),
+		available_credit_limit = available_credit_limit + COALESCE($2 - credit_limit, 0),
+		updated_at = CURRENT_TIMESTAMP
+	WHERE account_id=$1
+	RETURNING account_id, document_number, currency, balance, credit_limit, available_credit_limit, status, created_at, updated_at;
+	`
+
+	getAccountBalanceQuery = `SELECT account_id, balance, currency, CURRENT_TIMESTAMP AS as_of FROM accounts WHERE account_id=$1;`
+
+	getAccountBalanceAsOfQuery = `
+	SELECT a.account_id, COALESCE(SUM(t.amount), 0)::BIGINT AS balance, a.currency, $2::TIMESTAMPTZ AS as_of
+	FROM accounts a
+	LEFT JOIN transactions t ON t.account_id = a.account_id AND t.event_date <= $2
+	WHERE a.account_id=$1
+	GROUP BY a.account_id;
+	`
+
+	// Debits use up the available credit limit and credits restore it
+	updateAccountBalanceQuery = `
+	UPDATE accounts SET
+		balance = balance + $2,
+		available_credit_limit = available_credit_limit + $2
+	WHERE account_id=$1
+	RETURNING account_id, document_number, currency, balance, credit_limit, available_credit_limit, status, created_at, updated_at;
+	`
 )
 
-// CreateAccount creates a new Account.
-func (dr *dataRepo) CreateAccount(ctx context.Context, req CreateAccountReqParams) error {
-	_, err := dr.db.ExecContext(
-		ctx,
-		createAccountQuery,
-		req.DocumentNumber,
-	)
+// CreateAccount creates a new Account and returns the persisted row.
+func (dr *dataRepo) CreateAccount(ctx context.Context, req CreateAccountReqParams) (*AccountResponse, error) {
+	var res AccountResponse
+	err := dr.execTxn(ctx, func(tx *sqlx.Tx, audit *auditTrail) error {
+		if err := tx.GetContext(
+			ctx,
+			&res,
+			createAccountQuery,
+			req.DocumentNumber,
+			req.Currency,
+			req.CreditLimit,
+		); err != nil {
+			if isUniqueViolation(err, accountsDocumentNumberKey) {
+				return ErrDocumentNumberAlreadyExists
+			}
+			return err
+		}
+
+		audit.record(AuditEntityAccount, res.AccountID, AuditActionCreated, nil, res)
+		return nil
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return &res, nil
 }
 
 // GetAccountByAccountID returns the Account from the database using the provided account ID.
@@ -39,3 +93,125 @@ func (dr *dataRepo) GetAccountByAccountID(ctx context.Context, accountID int) (*
 
 	return &res, nil
 }
+
+// GetAccountByDocumentNumber returns the Account from the database using the provided document number.
+func (dr *dataRepo) GetAccountByDocumentNumber(ctx context.Context, documentNumber string) (*AccountResponse, error) {
+	var res AccountResponse
+	err := dr.db.GetContext(
+		ctx,
+		&res,
+		getAccountByDocumentNumberQuery,
+		documentNumber,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// UpdateAccount partially updates the Account and returns the updated row.
+// sql.ErrNoRows is returned when the account doesn't exist.
+func (dr *dataRepo) UpdateAccount(ctx context.Context, req UpdateAccountReqParams) (*AccountResponse, error) {
+	var res AccountResponse
+	err := dr.execTxn(ctx, func(tx *sqlx.Tx, audit *auditTrail) error {
+		account, err := lockAccount(ctx, tx, req.AccountID)
+		if err != nil {
+			return err
+		}
+
+		// The credit limit is held in the minor units of the account currency, and can't be lowered
+		// below the credit used by the outstanding debits and the holds of the account
+		var creditLimit *int64
+		if req.CreditLimit != nil {
+			if req.CreditLimit.Currency != account.Currency {
+				return ErrCurrencyMismatch
+			}
+			if account.AvailableCreditLimit+req.CreditLimit.Amount-account.CreditLimit < 0 {
+				return ErrCreditLimitInUse
+			}
+			creditLimit = &req.CreditLimit.Amount
+		}
+
+		if err := tx.GetContext(
+			ctx,
+			&res,
+			updateAccountQuery,
+			req.AccountID,
+			creditLimit,
+		); err != nil {
+			return err
+		}
+
+		audit.record(AuditEntityAccount, res.AccountID, AuditActionUpdated, *account, res)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetAccountBalance returns the balance of the Account using the provided account ID.
+// The materialized balance is returned when asOf is nil, otherwise the balance is summed
+// from the transactions with an event date up to asOf.
+func (dr *dataRepo) GetAccountBalance(ctx context.Context, accountID int, asOf *time.Time) (*BalanceResponse, error) {
+	query, args := getAccountBalanceQuery, []interface{}{accountID}
+	if asOf != nil {
+		query, args = getAccountBalanceAsOfQuery, []interface{}{accountID, *asOf}
+	}
+
+	var res BalanceResponse
+	err := dr.db.GetContext(
+		ctx,
+		&res,
+		query,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// lockAccount returns the Account row locked for update until the end of the transaction.
+// The whole row is read, for the changes of the account to be audited with the account as it was.
+func lockAccount(ctx context.Context, tx *sqlx.Tx, accountID int) (*AccountResponse, error) {
+	var account AccountResponse
+	if err := tx.GetContext(
+		ctx,
+		&account,
+		lockAccountQuery,
+		accountID,
+	); err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// updateAccountBalance changes the materialized balance and available credit limit of the locked account
+// by the amount, records the change in the audit trail, and returns the updated account.
+func updateAccountBalance(ctx context.Context, tx *sqlx.Tx, audit *auditTrail, account AccountResponse, amount int64) (*AccountResponse, error) {
+	return changeLockedAccount(ctx, tx, audit, account, updateAccountBalanceQuery, amount)
+}
+
+// changeLockedAccount runs the update query of the locked account with the amount, and records the
+// account as it was and as it's returned by the query in the audit trail.
+func changeLockedAccount(ctx context.Context, tx *sqlx.Tx, audit *auditTrail, before AccountResponse, query string, amount int64) (*AccountResponse, error) {
+	var after AccountResponse
+	if err := tx.GetContext(
+		ctx,
+		&after,
+		query,
+		before.AccountID,
+		amount,
+	); err != nil {
+		return nil, err
+	}
+
+	audit.record(AuditEntityAccount, after.AccountID, AuditActionUpdated, before, after)
+	return &after, nil
+}
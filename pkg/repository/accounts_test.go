@@ -9,6 +9,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -48,32 +49,103 @@ func TestAccountsTableSuite(t *testing.T) {
 	suite.Run(t, new(testAccountsTableSuite))
 }
 
+// accountRows returns the mocked rows for the given Accounts data.
+func accountRows(accounts ...*AccountResponse) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"account_id", "document_number", "currency", "balance", "credit_limit", "available_credit_limit", "status", "created_at", "updated_at"})
+	for _, account := range accounts {
+		rows.AddRow(
+			account.AccountID,
+			account.DocumentNumber,
+			account.Currency,
+			account.Balance,
+			account.CreditLimit,
+			account.AvailableCreditLimit,
+			account.Status,
+			account.CreatedAt,
+			account.UpdatedAt,
+		)
+	}
+	return rows
+}
+
 // @Success testcase
 func (s *testAccountsTableSuite) TestCreateAccountSuccess() {
 	req := CreateAccountReqParams{
 		DocumentNumber: "1234567",
+		Currency:       "BRL",
+		CreditLimit:    500000,
+	}
+
+	t := time.Now()
+	expected := &AccountResponse{
+		AccountID:            1,
+		DocumentNumber:       req.DocumentNumber,
+		Currency:             req.Currency,
+		CreditLimit:          req.CreditLimit,
+		AvailableCreditLimit: req.CreditLimit,
+		CreatedAt:            t,
+		UpdatedAt:            t,
 	}
 
-	s.mock.ExpectExec(createAccountQuery).
-		WithArgs(req.DocumentNumber).
-		WillReturnResult(sqlmock.NewResult(1, 1))
+	sqlResponse := accountRows(expected)
 
-	err := s.repo.CreateAccount(context.Background(), req)
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(createAccountQuery).
+		WithArgs(req.DocumentNumber, req.Currency, req.CreditLimit).
+		WillReturnRows(sqlResponse)
+
+	expectAuditEntries(s.mock, []string{AuditEntityAccount}, []int64{1}, []string{AuditActionCreated})
+
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.CreateAccount(context.Background(), req)
 	s.Require().NoError(err)
+
+	s.Equal(expected, actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
 }
 
 // @Failed testcase
 func (s *testAccountsTableSuite) TestCreateAccountError() {
 	req := CreateAccountReqParams{
 		DocumentNumber: "1234567",
+		Currency:       "BRL",
 	}
 
-	s.mock.ExpectExec(createAccountQuery).
-		WithArgs(req.DocumentNumber).
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(createAccountQuery).
+		WithArgs(req.DocumentNumber, req.Currency, req.CreditLimit).
 		WillReturnError(errors.New("something went wrong"))
 
-	err := s.repo.CreateAccount(context.Background(), req)
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CreateAccount(context.Background(), req)
 	s.Require().Error(err)
+
+	s.Require().Nil(actual)
+}
+
+// @Failed testcase
+func (s *testAccountsTableSuite) TestCreateAccountDocumentNumberAlreadyExists() {
+	req := CreateAccountReqParams{
+		DocumentNumber: "1234567",
+		Currency:       "BRL",
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(createAccountQuery).
+		WithArgs(req.DocumentNumber, req.Currency, req.CreditLimit).
+		WillReturnError(&pq.Error{Code: pqUniqueViolation, Constraint: accountsDocumentNumberKey})
+
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CreateAccount(context.Background(), req)
+	s.Require().ErrorIs(err, ErrDocumentNumberAlreadyExists)
+
+	s.Require().Nil(actual)
 }
 
 // @Success testcase
@@ -86,13 +158,7 @@ func (s *testAccountsTableSuite) TestGetAccountByAccountIDSuccess() {
 		UpdatedAt:      t,
 	}
 
-	sqlResponse := sqlmock.NewRows([]string{"account_id", "document_number", "created_at", "updated_at"}).
-		AddRow(
-			expected.AccountID,
-			expected.DocumentNumber,
-			expected.CreatedAt,
-			expected.UpdatedAt,
-		)
+	sqlResponse := accountRows(expected)
 
 	s.mock.ExpectQuery(getAccountByAccountIDQuery).
 		WithArgs(expected.AccountID).
@@ -131,3 +197,227 @@ func (s *testAccountsTableSuite) TestGetAccountByAccountIDInternalServerError()
 
 	s.Require().Nil(actual)
 }
+
+// @Success testcase
+func (s *testAccountsTableSuite) TestGetAccountByDocumentNumberSuccess() {
+	t := time.Now()
+	expected := &AccountResponse{
+		AccountID:      1,
+		DocumentNumber: "1234567",
+		CreatedAt:      t,
+		UpdatedAt:      t,
+	}
+
+	sqlResponse := accountRows(expected)
+
+	s.mock.ExpectQuery(getAccountByDocumentNumberQuery).
+		WithArgs(expected.DocumentNumber).
+		WillReturnRows(sqlResponse)
+
+	actual, err := s.repo.GetAccountByDocumentNumber(context.Background(), expected.DocumentNumber)
+	s.Require().NoError(err)
+
+	s.Equal(expected, actual)
+}
+
+// @Failed testcase
+func (s *testAccountsTableSuite) TestGetAccountByDocumentNumberNoRowsError() {
+	documentNumber := "1234567"
+
+	s.mock.ExpectQuery(getAccountByDocumentNumberQuery).
+		WithArgs(documentNumber).
+		WillReturnError(sql.ErrNoRows)
+
+	actual, err := s.repo.GetAccountByDocumentNumber(context.Background(), documentNumber)
+	s.Require().ErrorIs(err, sql.ErrNoRows)
+
+	s.Require().Nil(actual)
+}
+
+// @Success testcase
+func (s *testAccountsTableSuite) TestGetAccountBalanceSuccess() {
+	expected := &BalanceResponse{
+		AccountID: 1,
+		Balance:   -12045,
+		Currency:  "BRL",
+		AsOf:      time.Now(),
+	}
+
+	sqlResponse := sqlmock.NewRows([]string{"account_id", "balance", "currency", "as_of"}).
+		AddRow(expected.AccountID, expected.Balance, expected.Currency, expected.AsOf)
+
+	s.mock.ExpectQuery(getAccountBalanceQuery).
+		WithArgs(expected.AccountID).
+		WillReturnRows(sqlResponse)
+
+	actual, err := s.repo.GetAccountBalance(context.Background(), expected.AccountID, nil)
+	s.Require().NoError(err)
+
+	s.Equal(expected, actual)
+}
+
+// @Success testcase
+func (s *testAccountsTableSuite) TestGetAccountBalanceAsOfSuccess() {
+	asOf := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	expected := &BalanceResponse{
+		AccountID: 1,
+		Balance:   5000,
+		Currency:  "USD",
+		AsOf:      asOf,
+	}
+
+	sqlResponse := sqlmock.NewRows([]string{"account_id", "balance", "currency", "as_of"}).
+		AddRow(expected.AccountID, expected.Balance, expected.Currency, expected.AsOf)
+
+	s.mock.ExpectQuery(getAccountBalanceAsOfQuery).
+		WithArgs(expected.AccountID, asOf).
+		WillReturnRows(sqlResponse)
+
+	actual, err := s.repo.GetAccountBalance(context.Background(), expected.AccountID, &asOf)
+	s.Require().NoError(err)
+
+	s.Equal(expected, actual)
+}
+
+// @Failed testcase
+func (s *testAccountsTableSuite) TestGetAccountBalanceNoRowsError() {
+	account_id := 1
+
+	s.mock.ExpectQuery(getAccountBalanceQuery).
+		WithArgs(account_id).
+		WillReturnError(sql.ErrNoRows)
+
+	actual, err := s.repo.GetAccountBalance(context.Background(), account_id, nil)
+	s.Require().ErrorIs(err, sql.ErrNoRows)
+
+	s.Require().Nil(actual)
+}
+
+// @Success testcase
+func (s *testAccountsTableSuite) TestUpdateAccountSuccess() {
+	req := UpdateAccountReqParams{
+		AccountID:   1,
+		CreditLimit: &Money{Amount: 250000, Currency: "BRL"},
+	}
+
+	// 50000 of the credit limit is in use, and stays in use with the new limit
+	t := time.Now()
+	expected := &AccountResponse{
+		AccountID:            req.AccountID,
+		DocumentNumber:       "1234567",
+		Currency:             "BRL",
+		CreditLimit:          250000,
+		AvailableCreditLimit: 200000,
+		CreatedAt:            t,
+		UpdatedAt:            t,
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID, DocumentNumber: "1234567", Currency: "BRL", CreditLimit: 150000, AvailableCreditLimit: 100000, CreatedAt: t, UpdatedAt: t}))
+
+	s.mock.ExpectQuery(updateAccountQuery).
+		WithArgs(req.AccountID, req.CreditLimit.Amount).
+		WillReturnRows(accountRows(expected))
+
+	expectAuditEntries(s.mock, []string{AuditEntityAccount}, []int64{1}, []string{AuditActionUpdated})
+
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.UpdateAccount(context.Background(), req)
+	s.Require().NoError(err)
+
+	s.Equal(expected, actual)
+}
+
+// @Failed testcase
+func (s *testAccountsTableSuite) TestUpdateAccountCurrencyMismatch() {
+	req := UpdateAccountReqParams{
+		AccountID:   1,
+		CreditLimit: &Money{Amount: 250000, Currency: "USD"},
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID, Currency: "BRL"}))
+
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.UpdateAccount(context.Background(), req)
+	s.Require().ErrorIs(err, ErrCurrencyMismatch)
+
+	s.Require().Nil(actual)
+}
+
+// @Failed testcase
+func (s *testAccountsTableSuite) TestUpdateAccountCreditLimitInUse() {
+	req := UpdateAccountReqParams{
+		AccountID:   1,
+		CreditLimit: &Money{Amount: 30000, Currency: "BRL"},
+	}
+
+	s.mock.ExpectBegin()
+
+	// 50000 of the credit limit is in use, more than the new limit
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID, Currency: "BRL", CreditLimit: 150000, AvailableCreditLimit: 100000}))
+
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.UpdateAccount(context.Background(), req)
+	s.Require().ErrorIs(err, ErrCreditLimitInUse)
+
+	s.Require().Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testAccountsTableSuite) TestUpdateAccountNoRowsError() {
+	req := UpdateAccountReqParams{
+		AccountID:   1,
+		CreditLimit: &Money{Amount: 250000, Currency: "BRL"},
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnError(sql.ErrNoRows)
+
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.UpdateAccount(context.Background(), req)
+	s.Require().ErrorIs(err, sql.ErrNoRows)
+
+	s.Require().Nil(actual)
+}
+
+// @Success testcase
+func (s *testAccountsTableSuite) TestUpdateAccountBalanceAudited() {
+	before := AccountResponse{AccountID: 1, Currency: "BRL", CreditLimit: 10000, AvailableCreditLimit: 10000, Status: AccountStatusActive}
+	after := before
+	after.Balance, after.AvailableCreditLimit = -3000, 7000
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(updateAccountBalanceQuery).
+		WithArgs(1, int64(-3000)).
+		WillReturnRows(accountRows(&after))
+
+	tx, err := s.db.Beginx()
+	s.Require().NoError(err)
+
+	audit := &auditTrail{}
+	actual, err := updateAccountBalance(context.Background(), tx, audit, before, -3000)
+	s.Require().NoError(err)
+
+	// The balance change is audited as an update of the account
+	s.Equal(&after, actual)
+	s.Equal([]auditEntry{{entity: AuditEntityAccount, entityID: 1, action: AuditActionUpdated, before: before, after: after}}, audit.entries)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aswinudhayakumar/account-transactions/internal/requestctx"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Audited entities.
+const (
+	AuditEntityAccount       = "account"
+	AuditEntityTransaction   = "transaction"
+	AuditEntityOperationType = "operation_type"
+	AuditEntityTransfer      = "transfer"
+	AuditEntityHold          = "hold"
+)
+
+// Audited actions.
+const (
+	AuditActionCreated       = "created"
+	AuditActionUpdated       = "updated"
+	AuditActionStatusChanged = "status_changed"
+	AuditActionReversed      = "reversed"
+	AuditActionSettled       = "settled"
+	AuditActionCaptured      = "captured"
+	AuditActionVoided        = "voided"
+	AuditActionExpired       = "expired"
+)
+
+const (
+	// The entries of a database transaction are written at once, NULLIF turns the missing before or after into NULL
+	createAuditEntriesQuery = `
+	INSERT INTO audit_log (entity, entity_id, action, actor, request_id, before, after)
+	SELECT e.entity, e.entity_id, e.action, $1, NULLIF($2, ''), NULLIF(e.before, '')::JSONB, NULLIF(e.after, '')::JSONB
+	FROM UNNEST($3::TEXT[], $4::INT[], $5::TEXT[], $6::TEXT[], $7::TEXT[])
+		WITH ORDINALITY AS e(entity, entity_id, action, before, after, position)
+	ORDER BY e.position;
+	`
+
+	listAuditEntriesQuery = `
+	SELECT audit_id, entity, entity_id, action, actor, COALESCE(request_id, '') AS request_id, before, after, created_at
+	FROM audit_log
+	WHERE entity=$1 AND entity_id=$2 AND audit_id > $3
+	ORDER BY audit_id
+	LIMIT $4;
+	`
+)
+
+// auditTrail collects the changes made in a database transaction, written to the audit log by execTxn
+// just before the transaction commits.
+type auditTrail struct {
+	entries []auditEntry
+}
+
+// auditEntry is a change collected by an auditTrail.
+type auditEntry struct {
+	entity   string
+	entityID int
+	action   string
+	before   interface{}
+	after    interface{}
+}
+
+// record adds a change of the entity to the audit trail, with the entity before and after it.
+// before is nil for a created entity. The states are encoded as JSON when the trail is written,
+// so they must be passed by value to keep them as they were when recorded.
+func (a *auditTrail) record(entity string, entityID int, action string, before, after interface{}) {
+	a.entries = append(a.entries, auditEntry{
+		entity:   entity,
+		entityID: entityID,
+		action:   action,
+		before:   before,
+		after:    after,
+	})
+}
+
+// write inserts the entries of the audit trail into the audit log, with the actor and request ID of the context.
+func (a *auditTrail) write(ctx context.Context, tx *sqlx.Tx) error {
+	if len(a.entries) == 0 {
+		return nil
+	}
+
+	entities := make([]string, len(a.entries))
+	entityIDs := make([]int64, len(a.entries))
+	actions := make([]string, len(a.entries))
+	befores := make([]string, len(a.entries))
+	afters := make([]string, len(a.entries))
+	for i, entry := range a.entries {
+		entities[i] = entry.entity
+		entityIDs[i] = int64(entry.entityID)
+		actions[i] = entry.action
+
+		var err error
+		if befores[i], err = auditState(entry.before); err != nil {
+			return err
+		}
+		if afters[i], err = auditState(entry.after); err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.ExecContext(
+		ctx,
+		createAuditEntriesQuery,
+		requestctx.Actor(ctx),
+		requestctx.RequestID(ctx),
+		pq.Array(entities),
+		pq.Array(entityIDs),
+		pq.Array(actions),
+		pq.Array(befores),
+		pq.Array(afters),
+	)
+	return err
+}
+
+// auditState returns the JSON encoding of an audited entity, empty when there's none.
+func auditState(state interface{}) (string, error) {
+	if state == nil {
+		return "", nil
+	}
+
+	b, err := json.Marshal(state)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode the audited entity: %w", err)
+	}
+	return string(b), nil
+}
+
+// ListAuditEntries returns a page of the audit log entries of an entity, ordered by audit ID.
+// The page starts after the audit ID given as cursor, and NextCursor is set when more entries exist.
+func (dr *dataRepo) ListAuditEntries(ctx context.Context, req ListAuditEntriesReqParams) (*AuditEntriesPage, error) {
+	// Fetch one extra row to know if there is a next page
+	entries := []AuditEntryResponse{}
+	err := dr.db.SelectContext(
+		ctx,
+		&entries,
+		listAuditEntriesQuery,
+		req.Entity,
+		req.EntityID,
+		req.Cursor,
+		req.Limit+1,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	page := AuditEntriesPage{
+		Entries: entries,
+	}
+	if len(entries) > req.Limit {
+		page.Entries = entries[:req.Limit]
+		nextCursor := page.Entries[req.Limit-1].AuditID
+		page.NextCursor = &nextCursor
+	}
+
+	return &page, nil
+}
@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aswinudhayakumar/account-transactions/internal/requestctx"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/suite"
+)
+
+// testAuditSuite is a test suite object to test the audit log.
+type testAuditSuite struct {
+	suite.Suite
+
+	db   *sqlx.DB
+	mock sqlmock.Sqlmock
+	repo DataRepo
+}
+
+// SetupTest setups and initializes the testAuditSuite.
+func (s *testAuditSuite) SetupTest() {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	s.Require().NoError(err)
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+
+	s.db = sqlxDB
+	s.mock = mock
+	s.repo = NewDataRepo(sqlxDB)
+}
+
+// TearDownTest gracefully closes the test suite, by closing the db connection.
+func (s *testAuditSuite) TearDownTest() {
+	if s.db != nil {
+		err := s.db.Close()
+		if err != nil {
+			return
+		}
+	}
+}
+
+// TestAuditSuite is the custom test suite to test the audit log.
+func TestAuditSuite(t *testing.T) {
+	suite.Run(t, new(testAuditSuite))
+}
+
+// expectAuditEntries sets the expectation to write the audit log entries of the given entities and actions,
+// made without an actor or request ID in the context. The audited states are not checked.
+func expectAuditEntries(mock sqlmock.Sqlmock, entities []string, entityIDs []int64, actions []string) {
+	mock.ExpectExec(createAuditEntriesQuery).
+		WithArgs(
+			requestctx.SystemActor,
+			"",
+			pq.Array(entities),
+			pq.Array(entityIDs),
+			pq.Array(actions),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+		).WillReturnResult(sqlmock.NewResult(0, int64(len(entities))))
+}
+
+// auditEntryRows returns the mocked rows for the given audit log entries.
+func auditEntryRows(entries ...AuditEntryResponse) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"audit_id", "entity", "entity_id", "action", "actor", "request_id", "before", "after", "created_at"})
+	for _, entry := range entries {
+		rows.AddRow(entry.AuditID, entry.Entity, entry.EntityID, entry.Action, entry.Actor, entry.RequestID, entry.Before, entry.After, entry.CreatedAt)
+	}
+	return rows
+}
+
+// @Success testcase
+func (s *testAuditSuite) TestAuditEntriesWrittenWithRequestContext() {
+	req := UpdateAccountStatusReqParams{
+		AccountID: 1,
+		Status:    AccountStatusBlocked,
+		Reason:    "Suspected fraud",
+	}
+
+	t := time.Date(2025, 2, 10, 10, 0, 0, 0, time.UTC)
+	expected := &AccountResponse{
+		AccountID:      req.AccountID,
+		DocumentNumber: "1234567",
+		Currency:       "BRL",
+		Status:         AccountStatusBlocked,
+		CreatedAt:      t,
+		UpdatedAt:      t,
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID, DocumentNumber: "1234567", Currency: "BRL", AvailableCreditLimit: 1000, Status: AccountStatusActive, CreatedAt: t, UpdatedAt: t}))
+
+	s.mock.ExpectQuery(updateAccountStatusQuery).
+		WithArgs(req.AccountID, req.Status).
+		WillReturnRows(accountRows(expected))
+
+	s.mock.ExpectExec(createAccountStatusHistoryQuery).
+		WithArgs(req.AccountID, AccountStatusActive, AccountStatusBlocked, req.Reason).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	s.mock.ExpectExec(createAuditEntriesQuery).
+		WithArgs(
+			"compliance@example.com",
+			"0194f1a2-7c3e-7d00-8000-000000000001",
+			pq.Array([]string{AuditEntityAccount}),
+			pq.Array([]int64{1}),
+			pq.Array([]string{AuditActionStatusChanged}),
+			pq.Array([]string{`{"account_id":1,"document_number":"1234567","currency":"BRL","balance":0,"credit_limit":0,"available_credit_limit":1000,"status":"active","created_at":"2025-02-10T10:00:00Z","updated_at":"2025-02-10T10:00:00Z"}`}),
+			pq.Array([]string{`{"account_id":1,"document_number":"1234567","currency":"BRL","balance":0,"credit_limit":0,"available_credit_limit":0,"status":"blocked","created_at":"2025-02-10T10:00:00Z","updated_at":"2025-02-10T10:00:00Z"}`}),
+		).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.mock.ExpectCommit()
+
+	ctx := requestctx.WithActor(context.Background(), "compliance@example.com")
+	ctx = requestctx.WithRequestID(ctx, "0194f1a2-7c3e-7d00-8000-000000000001")
+	actual, err := s.repo.UpdateAccountStatus(ctx, req)
+	s.Require().NoError(err)
+
+	s.Equal(expected, actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Success testcase
+func (s *testAuditSuite) TestAuditEntriesCreatedEntity() {
+	req := CreateOperationTypeReqParams{
+		Description: "CASHBACK",
+		Sign:        1,
+		IsActive:    true,
+	}
+
+	t := time.Date(2025, 2, 10, 10, 0, 0, 0, time.UTC)
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(createOperationTypeQuery).
+		WithArgs(req.Description, req.Sign, req.IsActive, req.AllowsInstallments).
+		WillReturnRows(sqlmock.NewRows([]string{"operation_type_id", "description", "sign", "is_active", "allows_installments", "created_at", "updated_at"}).
+			AddRow(5, req.Description, req.Sign, req.IsActive, req.AllowsInstallments, t, t))
+
+	// A created entity has nothing before it, written as an empty string turned into NULL
+	s.mock.ExpectExec(createAuditEntriesQuery).
+		WithArgs(
+			requestctx.SystemActor,
+			"",
+			pq.Array([]string{AuditEntityOperationType}),
+			pq.Array([]int64{5}),
+			pq.Array([]string{AuditActionCreated}),
+			pq.Array([]string{""}),
+			pq.Array([]string{`{"operation_type_id":5,"description":"CASHBACK","sign":1,"is_active":true,"allows_installments":false,"created_at":"2025-02-10T10:00:00Z","updated_at":"2025-02-10T10:00:00Z"}`}),
+		).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.mock.ExpectCommit()
+
+	_, err := s.repo.CreateOperationType(context.Background(), req)
+	s.Require().NoError(err)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testAuditSuite) TestAuditEntriesWriteError() {
+	req := CreateOperationTypeReqParams{
+		Description: "CASHBACK",
+		Sign:        1,
+		IsActive:    true,
+	}
+
+	t := time.Now()
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(createOperationTypeQuery).
+		WithArgs(req.Description, req.Sign, req.IsActive, req.AllowsInstallments).
+		WillReturnRows(sqlmock.NewRows([]string{"operation_type_id", "description", "sign", "is_active", "allows_installments", "created_at", "updated_at"}).
+			AddRow(5, req.Description, req.Sign, req.IsActive, req.AllowsInstallments, t, t))
+
+	s.mock.ExpectExec(createAuditEntriesQuery).
+		WillReturnError(errors.New("something went wrong"))
+
+	// The change is rolled back when it can't be audited
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CreateOperationType(context.Background(), req)
+	s.Require().Error(err)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Success testcase
+func (s *testAuditSuite) TestListAuditEntriesSuccess() {
+	req := ListAuditEntriesReqParams{
+		Entity:   AuditEntityAccount,
+		EntityID: 1,
+		Cursor:   0,
+		Limit:    1,
+	}
+
+	t := time.Now()
+	created := AuditEntryResponse{AuditID: 1, Entity: AuditEntityAccount, EntityID: 1, Action: AuditActionCreated, Actor: "anonymous", RequestID: "req-1", After: []byte(`{"account_id":1}`), CreatedAt: t}
+	updated := AuditEntryResponse{AuditID: 2, Entity: AuditEntityAccount, EntityID: 1, Action: AuditActionUpdated, Actor: "anonymous", RequestID: "req-2", Before: []byte(`{"account_id":1}`), After: []byte(`{"account_id":1}`), CreatedAt: t}
+
+	s.mock.ExpectQuery(listAuditEntriesQuery).
+		WithArgs(req.Entity, req.EntityID, req.Cursor, req.Limit+1).
+		WillReturnRows(auditEntryRows(created, updated))
+
+	actual, err := s.repo.ListAuditEntries(context.Background(), req)
+	s.Require().NoError(err)
+
+	nextCursor := int64(1)
+	s.Equal(&AuditEntriesPage{Entries: []AuditEntryResponse{created}, NextCursor: &nextCursor}, actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testAuditSuite) TestListAuditEntriesError() {
+	req := ListAuditEntriesReqParams{
+		Entity:   AuditEntityAccount,
+		EntityID: 1,
+		Limit:    50,
+	}
+
+	s.mock.ExpectQuery(listAuditEntriesQuery).
+		WithArgs(req.Entity, req.EntityID, req.Cursor, req.Limit+1).
+		WillReturnError(errors.New("something went wrong"))
+
+	actual, err := s.repo.ListAuditEntries(context.Background(), req)
+	s.Require().Error(err)
+	s.Nil(actual)
+}
@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const (
+	lockAccountsQuery = `SELECT account_id, document_number, currency, balance, credit_limit, available_credit_limit, status, created_at, updated_at FROM accounts WHERE account_id = ANY($1) ORDER BY account_id FOR UPDATE;`
+
+	listOperationTypeSignsQuery = `SELECT operation_type_id, sign, is_active, allows_installments, system_key IS NOT NULL AS is_system FROM operations_types WHERE operation_type_id = ANY($1);`
+
+	// The rows are inserted in the order of the batch, so their transaction IDs follow it
+	createTransactionsQuery = `
+	INSERT INTO transactions (account_id, operation_type_id, amount, currency, balance, event_date)
+	SELECT t.account_id, t.operation_type_id, t.amount, t.currency, t.amount, COALESCE(NULLIF(t.event_date, '')::TIMESTAMPTZ, CURRENT_TIMESTAMP)
+	FROM UNNEST($1::INT[], $2::INT[], $3::BIGINT[], $4::TEXT[], $5::TEXT[])
+		WITH ORDINALITY AS t(account_id, operation_type_id, amount, currency, event_date, position)
+	ORDER BY t.position
+	RETURNING transaction_id, account_id, operation_type_id, amount, currency, balance, status, reverses_transaction_id, transfer_id, event_date, created_at, updated_at;
+	`
+)
+
+// operationTypeSign holds the Operation type data needed to post a transaction.
+type operationTypeSign struct {
+	OperationTypeID    int  `db:"operation_type_id"`
+	Sign               int  `db:"sign"`
+	IsActive           bool `db:"is_active"`
+	AllowsInstallments bool `db:"allows_installments"`
+	IsSystem           bool `db:"is_system"`
+}
+
+// CreateTransactions creates a batch of transactions with a single insert, as if they were posted one by one
+// in order. The result of each transaction is returned at its index in the batch. The transactions that can't
+// be posted are skipped with their error, or the whole batch is rolled back with ErrBatchRejected when atomic.
+// Debits are returned as posted, without the settlements of the later credits of the batch.
+// Idempotency keys are not supported in a batch.
+func (dr *dataRepo) CreateTransactions(ctx context.Context, req CreateTransactionsReqParams) ([]BatchTransactionResult, error) {
+	results := make([]BatchTransactionResult, len(req.Transactions))
+	err := dr.execTxn(ctx, func(tx *sqlx.Tx, audit *auditTrail) error {
+		accountIDs := make([]int64, len(req.Transactions))
+		operationTypeIDs := make([]int64, len(req.Transactions))
+		for i, trxReq := range req.Transactions {
+			accountIDs[i] = int64(trxReq.AccountID)
+			operationTypeIDs[i] = int64(trxReq.OperationTypeID)
+		}
+
+		// Lock all the accounts of the batch in account ID order to avoid deadlocks
+		accounts := []AccountResponse{}
+		if err := tx.SelectContext(
+			ctx,
+			&accounts,
+			lockAccountsQuery,
+			pq.Array(accountIDs),
+		); err != nil {
+			return err
+		}
+
+		operationTypes := []operationTypeSign{}
+		if err := tx.SelectContext(
+			ctx,
+			&operationTypes,
+			listOperationTypeSignsQuery,
+			pq.Array(operationTypeIDs),
+		); err != nil {
+			return err
+		}
+
+		// The transactions are checked against copies of the accounts, the locked accounts are audited as they were
+		accountsByID := make(map[int]*AccountResponse, len(accounts))
+		for _, account := range accounts {
+			account := account
+			accountsByID[account.AccountID] = &account
+		}
+		operationTypesByID := make(map[int]operationTypeSign, len(operationTypes))
+		for _, operationType := range operationTypes {
+			operationTypesByID[operationType.OperationTypeID] = operationType
+		}
+
+		// Validate the transactions in order, each one using up or restoring the credit limit for the next ones
+		var (
+			indexes        []int
+			trxAccountIDs  []int64
+			trxOpTypeIDs   []int64
+			trxAmounts     []int64
+			trxCurrencies  []string
+			trxEventDates  []string
+			balanceChanges = make(map[int]int64)
+		)
+		for i, trxReq := range req.Transactions {
+			amount, err := checkBatchTransaction(trxReq, accountsByID[trxReq.AccountID], operationTypesByID)
+			if err != nil {
+				results[i].Err = err
+				continue
+			}
+			accountsByID[trxReq.AccountID].AvailableCreditLimit += amount.Amount
+			balanceChanges[trxReq.AccountID] += amount.Amount
+
+			var eventDate string
+			if trxReq.EventDate != nil {
+				eventDate = trxReq.EventDate.Format(time.RFC3339Nano)
+			}
+			indexes = append(indexes, i)
+			trxAccountIDs = append(trxAccountIDs, int64(trxReq.AccountID))
+			trxOpTypeIDs = append(trxOpTypeIDs, int64(trxReq.OperationTypeID))
+			trxAmounts = append(trxAmounts, amount.Amount)
+			trxCurrencies = append(trxCurrencies, amount.Currency)
+			trxEventDates = append(trxEventDates, eventDate)
+		}
+
+		if req.Atomic && len(indexes) < len(req.Transactions) {
+			return ErrBatchRejected
+		}
+		if len(indexes) == 0 {
+			return nil
+		}
+
+		created := []TransactionResponse{}
+		if err := tx.SelectContext(
+			ctx,
+			&created,
+			createTransactionsQuery,
+			pq.Array(trxAccountIDs),
+			pq.Array(trxOpTypeIDs),
+			pq.Array(trxAmounts),
+			pq.Array(trxCurrencies),
+			pq.Array(trxEventDates),
+		); err != nil {
+			return err
+		}
+		sort.Slice(created, func(i, j int) bool {
+			return created[i].TransactionID < created[j].TransactionID
+		})
+
+		// Record where the money came from and went to in the ledger, for all the transactions at once
+		journals := make([]journal, 0, len(created))
+		for _, trx := range created {
+			journals = append(journals, transactionJournal(trx))
+		}
+		if err := postJournals(ctx, tx, journals); err != nil {
+			return err
+		}
+
+		for k, i := range indexes {
+			trx := &created[k]
+			audit.record(AuditEntityTransaction, trx.TransactionID, AuditActionCreated, nil, *trx)
+			if n := req.Transactions[i].Installments; n > 0 {
+				if err := createInstallments(ctx, tx, trx, n); err != nil {
+					return err
+				}
+			}
+
+			// A credit pays off the outstanding debits posted before it
+			if trx.Amount > 0 {
+				if err := settleDebits(ctx, tx, audit, trx); err != nil {
+					return err
+				}
+			}
+			results[i].Transaction = trx
+		}
+
+		// Keep the materialized balances and credit limits consistent, updating the accounts in lock order
+		for _, account := range accounts {
+			change, ok := balanceChanges[account.AccountID]
+			if !ok {
+				continue
+			}
+
+			if _, err := updateAccountBalance(ctx, tx, audit, account, change); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrBatchRejected) {
+			return results, err
+		}
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// checkBatchTransaction checks that the transaction can be posted to the locked account with the
+// available credit limit left by the earlier transactions of the batch, and returns its signed amount.
+func checkBatchTransaction(req CreateTransactionReqParams, account *AccountResponse, operationTypes map[int]operationTypeSign) (Money, error) {
+	if account == nil {
+		return Money{}, ErrAccountIDNotExists
+	}
+
+	if err := checkAccountStatus(account.Status); err != nil {
+		return Money{}, err
+	}
+
+	operationType, ok := operationTypes[req.OperationTypeID]
+	if !ok {
+		return Money{}, ErrOperationTypeIDNotExists
+	}
+
+	if !operationType.IsActive {
+		return Money{}, ErrOperationTypeInactive
+	}
+
+	if operationType.IsSystem {
+		return Money{}, ErrSystemOperationType
+	}
+
+	if req.Amount.Currency != account.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+
+	if req.Installments > 0 && !operationType.AllowsInstallments {
+		return Money{}, ErrInstallmentsNotAllowed
+	}
+
+	if err := checkInstallments(req.Amount, req.Installments); err != nil {
+		return Money{}, err
+	}
+
+	amount := applySign(req.Amount, operationType.Sign)
+	if amount.Amount < 0 && -amount.Amount > account.AvailableCreditLimit {
+		return Money{}, ErrInsufficientCreditLimit
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,263 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/suite"
+)
+
+// testBatchesSuite is a test suite object to test the creation of Transactions in batches.
+type testBatchesSuite struct {
+	suite.Suite
+
+	db   *sqlx.DB
+	mock sqlmock.Sqlmock
+	repo DataRepo
+}
+
+// SetupTest setups and initializes the testBatchesSuite.
+func (s *testBatchesSuite) SetupTest() {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	s.Require().NoError(err)
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+
+	s.db = sqlxDB
+	s.mock = mock
+	s.repo = NewDataRepo(sqlxDB)
+}
+
+// TearDownTest gracefully closes the test suite, by closing the db connection.
+func (s *testBatchesSuite) TearDownTest() {
+	if s.db != nil {
+		err := s.db.Close()
+		if err != nil {
+			return
+		}
+	}
+}
+
+// TestBatchesSuite is the custom test suite to test the creation of Transactions in batches.
+func TestBatchesSuite(t *testing.T) {
+	suite.Run(t, new(testBatchesSuite))
+}
+
+// batchReq returns a batch of a debit, a debit exceeding the credit limit left by the first one, and a credit.
+func batchReq(atomic bool) CreateTransactionsReqParams {
+	return CreateTransactionsReqParams{
+		Transactions: []CreateTransactionReqParams{
+			{AccountID: 1, OperationTypeID: 1, Amount: Money{Amount: 5000, Currency: "BRL"}},
+			{AccountID: 1, OperationTypeID: 1, Amount: Money{Amount: 8000, Currency: "BRL"}},
+			{AccountID: 1, OperationTypeID: 4, Amount: Money{Amount: 3000, Currency: "BRL"}},
+		},
+		Atomic: atomic,
+	}
+}
+
+// expectLockBatch sets the expectations to lock the accounts and read the operation types of batchReq.
+func (s *testBatchesSuite) expectLockBatch() {
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(lockAccountsQuery).
+		WithArgs(pq.Array([]int64{1, 1, 1})).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: 1, Currency: "BRL", AvailableCreditLimit: 10000}))
+
+	s.mock.ExpectQuery(listOperationTypeSignsQuery).
+		WithArgs(pq.Array([]int64{1, 1, 4})).
+		WillReturnRows(sqlmock.NewRows([]string{"operation_type_id", "sign", "is_active"}).
+			AddRow(1, -1, true).
+			AddRow(4, 1, true),
+		)
+}
+
+// @Success testcase
+func (s *testBatchesSuite) TestCreateTransactionsPartialSuccess() {
+	s.expectLockBatch()
+
+	t := time.Now()
+	debit := &TransactionResponse{TransactionID: 10, AccountID: 1, OperationTypeID: 1, Amount: -5000, Currency: "BRL", Balance: -5000, Status: TransactionStatusPosted, EventDate: t, CreatedAt: t, UpdatedAt: t}
+	credit := &TransactionResponse{TransactionID: 11, AccountID: 1, OperationTypeID: 4, Amount: 3000, Currency: "BRL", Balance: 3000, Status: TransactionStatusPosted, EventDate: t, CreatedAt: t, UpdatedAt: t}
+
+	// The rows may be returned in any order, they're matched to the batch by transaction ID
+	s.mock.ExpectQuery(createTransactionsQuery).
+		WithArgs(
+			pq.Array([]int64{1, 1}),
+			pq.Array([]int64{1, 4}),
+			pq.Array([]int64{-5000, 3000}),
+			pq.Array([]string{"BRL", "BRL"}),
+			pq.Array([]string{"", ""}),
+		).WillReturnRows(transactionRows(credit).AddRow(
+		debit.TransactionID, debit.AccountID, debit.OperationTypeID, debit.Amount, debit.Currency, debit.Balance,
+		debit.Status, debit.ReversesTransactionID, debit.TransferID, debit.EventDate, debit.CreatedAt, debit.UpdatedAt,
+	))
+
+	// The journals are posted in transaction ID order
+	expectJournals(s.mock, *debit, *credit)
+
+	// The credit settles the debit posted before it
+	s.mock.ExpectQuery(listOutstandingDebitsQuery).
+		WithArgs(credit.AccountID, credit.TransactionID).
+		WillReturnRows(sqlmock.NewRows([]string{"transaction_id", "balance"}).
+			AddRow(debit.TransactionID, debit.Balance),
+		)
+	s.mock.ExpectExec(updateTransactionBalanceQuery).
+		WithArgs(debit.TransactionID, int64(-2000)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(createSettlementQuery).
+		WithArgs(debit.TransactionID, credit.TransactionID, int64(3000)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(updateTransactionBalanceQuery).
+		WithArgs(credit.TransactionID, int64(0)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.mock.ExpectQuery(updateAccountBalanceQuery).
+		WithArgs(1, int64(-2000)).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: 1}))
+
+	expectAuditEntries(s.mock, []string{AuditEntityTransaction, AuditEntityTransaction, AuditEntityTransaction, AuditEntityTransaction, AuditEntityAccount}, []int64{10, 11, 10, 11, 1}, []string{AuditActionCreated, AuditActionCreated, AuditActionSettled, AuditActionSettled, AuditActionUpdated})
+
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.CreateTransactions(context.Background(), batchReq(false))
+	s.Require().NoError(err)
+	s.Require().Len(actual, 3)
+	s.Equal(debit, actual[0].Transaction)
+	s.NoError(actual[0].Err)
+	s.Nil(actual[1].Transaction)
+	s.ErrorIs(actual[1].Err, ErrInsufficientCreditLimit)
+	s.Equal(credit.TransactionID, actual[2].Transaction.TransactionID)
+	s.Equal(int64(0), actual[2].Transaction.Balance)
+	s.NoError(actual[2].Err)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testBatchesSuite) TestCreateTransactionsAtomicRejected() {
+	s.expectLockBatch()
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CreateTransactions(context.Background(), batchReq(true))
+	s.Require().ErrorIs(err, ErrBatchRejected)
+	s.Require().Len(actual, 3)
+	s.NoError(actual[0].Err)
+	s.ErrorIs(actual[1].Err, ErrInsufficientCreditLimit)
+	s.NoError(actual[2].Err)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testBatchesSuite) TestCreateTransactionsUnknownAccount() {
+	req := CreateTransactionsReqParams{
+		Transactions: []CreateTransactionReqParams{
+			{AccountID: 2, OperationTypeID: 1, Amount: Money{Amount: 5000, Currency: "BRL"}},
+		},
+	}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(lockAccountsQuery).
+		WithArgs(pq.Array([]int64{2})).
+		WillReturnRows(accountRows())
+	s.mock.ExpectQuery(listOperationTypeSignsQuery).
+		WithArgs(pq.Array([]int64{1})).
+		WillReturnRows(sqlmock.NewRows([]string{"operation_type_id", "sign", "is_active"}).AddRow(1, -1, true))
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.CreateTransactions(context.Background(), req)
+	s.Require().NoError(err)
+	s.Require().Len(actual, 1)
+	s.ErrorIs(actual[0].Err, ErrAccountIDNotExists)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testBatchesSuite) TestCreateTransactionsBlockedAccount() {
+	req := CreateTransactionsReqParams{
+		Transactions: []CreateTransactionReqParams{
+			{AccountID: 2, OperationTypeID: 4, Amount: Money{Amount: 5000, Currency: "BRL"}},
+		},
+	}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(lockAccountsQuery).
+		WithArgs(pq.Array([]int64{2})).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: 2, Currency: "BRL", Status: AccountStatusBlocked}))
+	s.mock.ExpectQuery(listOperationTypeSignsQuery).
+		WithArgs(pq.Array([]int64{4})).
+		WillReturnRows(sqlmock.NewRows([]string{"operation_type_id", "sign", "is_active"}).AddRow(4, 1, true))
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.CreateTransactions(context.Background(), req)
+	s.Require().NoError(err)
+	s.Require().Len(actual, 1)
+	s.ErrorIs(actual[0].Err, ErrAccountBlocked)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testBatchesSuite) TestCheckBatchTransactionInstallmentsNotAllowed() {
+	account := &AccountResponse{AccountID: 1, Currency: "BRL", AvailableCreditLimit: 10000}
+	operationTypes := map[int]operationTypeSign{
+		1: {OperationTypeID: 1, Sign: -1, IsActive: true},
+		5: {OperationTypeID: 5, Sign: -1, IsActive: true, AllowsInstallments: true},
+	}
+	req := CreateTransactionReqParams{AccountID: 1, OperationTypeID: 1, Amount: Money{Amount: 3000, Currency: "BRL"}, Installments: 3}
+
+	_, err := checkBatchTransaction(req, account, operationTypes)
+	s.ErrorIs(err, ErrInstallmentsNotAllowed)
+
+	// Any operation type allowing installments can be paid in installments
+	req.OperationTypeID = 5
+	amount, err := checkBatchTransaction(req, account, operationTypes)
+	s.Require().NoError(err)
+	s.Equal(Money{Amount: -3000, Currency: "BRL"}, amount)
+}
+
+// @Failed testcase
+func (s *testBatchesSuite) TestCheckBatchTransactionInstallmentsExceedAmount() {
+	account := &AccountResponse{AccountID: 1, Currency: "BRL", AvailableCreditLimit: 10000}
+	operationTypes := map[int]operationTypeSign{
+		5: {OperationTypeID: 5, Sign: -1, IsActive: true, AllowsInstallments: true},
+	}
+	req := CreateTransactionReqParams{AccountID: 1, OperationTypeID: 5, Amount: Money{Amount: 5, Currency: "BRL"}, Installments: 48}
+
+	_, err := checkBatchTransaction(req, account, operationTypes)
+	s.ErrorIs(err, ErrInstallmentsExceedAmount)
+}
+
+// @Failed testcase
+func (s *testBatchesSuite) TestCheckBatchTransactionSystemOperationType() {
+	account := &AccountResponse{AccountID: 1, Currency: "BRL", AvailableCreditLimit: 10000}
+	operationTypes := map[int]operationTypeSign{
+		6: {OperationTypeID: 6, Sign: 1, IsActive: true, IsSystem: true},
+	}
+	req := CreateTransactionReqParams{AccountID: 1, OperationTypeID: 6, Amount: Money{Amount: 3000, Currency: "BRL"}}
+
+	_, err := checkBatchTransaction(req, account, operationTypes)
+	s.ErrorIs(err, ErrSystemOperationType)
+}
+
+// @Failed testcase
+func (s *testBatchesSuite) TestCreateTransactionsError() {
+	s.expectLockBatch()
+
+	s.mock.ExpectQuery(createTransactionsQuery).
+		WithArgs(
+			pq.Array([]int64{1, 1}),
+			pq.Array([]int64{1, 4}),
+			pq.Array([]int64{-5000, 3000}),
+			pq.Array([]string{"BRL", "BRL"}),
+			pq.Array([]string{"", ""}),
+		).WillReturnError(errors.New("something went wrong"))
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CreateTransactions(context.Background(), batchReq(false))
+	s.Require().Error(err)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
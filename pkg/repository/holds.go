@@ -0,0 +1,444 @@
+package repository
+
+import (
+	"context"
+	"sort"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Hold statuses.
+const (
+	HoldStatusActive   = "active"
+	HoldStatusCaptured = "captured"
+	HoldStatusVoided   = "voided"
+	HoldStatusExpired  = "expired"
+)
+
+const (
+	createHoldQuery = `
+	INSERT INTO holds (account_id, operation_type_id, amount, currency, expires_at) VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP + $5 * INTERVAL '1 microsecond')
+	RETURNING hold_id, account_id, operation_type_id, amount, captured_amount, currency, status, transaction_id, expires_at, created_at, updated_at;
+	`
+
+	getHoldAccountIDQuery = `SELECT account_id FROM holds WHERE hold_id=$1;`
+
+	getHoldOperationTypeQuery = `
+	SELECT operation_type_id, sign, is_active, allows_installments, system_key IS NOT NULL AS is_system
+	FROM operations_types
+	WHERE operation_type_id=$1;
+	`
+
+	lockHoldQuery = `
+	SELECT hold_id, account_id, operation_type_id, amount, captured_amount, currency, status, transaction_id, expires_at, created_at, updated_at,
+		expires_at <= CURRENT_TIMESTAMP AS is_expired
+	FROM holds
+	WHERE hold_id=$1
+	FOR UPDATE;
+	`
+
+	captureHoldQuery = `
+	UPDATE holds SET status='captured', captured_amount=$2, transaction_id=$3, updated_at=CURRENT_TIMESTAMP
+	WHERE hold_id=$1
+	RETURNING hold_id, account_id, operation_type_id, amount, captured_amount, currency, status, transaction_id, expires_at, created_at, updated_at;
+	`
+
+	voidHoldQuery = `
+	UPDATE holds SET status='voided', updated_at=CURRENT_TIMESTAMP
+	WHERE hold_id=$1
+	RETURNING hold_id, account_id, operation_type_id, amount, captured_amount, currency, status, transaction_id, expires_at, created_at, updated_at;
+	`
+
+	listExpiredHoldsQuery = `
+	SELECT hold_id, account_id
+	FROM holds
+	WHERE status='active' AND expires_at <= CURRENT_TIMESTAMP
+	ORDER BY hold_id
+	LIMIT $1;
+	`
+
+	// The status is checked again, a hold may have been captured or voided before its account was locked
+	expireHoldsQuery = `
+	UPDATE holds SET status='expired', updated_at=CURRENT_TIMESTAMP
+	WHERE hold_id = ANY($1) AND status='active' AND expires_at <= CURRENT_TIMESTAMP
+	RETURNING hold_id, account_id, operation_type_id, amount, captured_amount, currency, status, transaction_id, expires_at, created_at, updated_at;
+	`
+
+	// Holds reserve the available credit limit without changing the balance
+	updateAccountAvailableCreditLimitQuery = `
+	UPDATE accounts SET
+		available_credit_limit = available_credit_limit + $2
+	WHERE account_id=$1
+	RETURNING account_id, document_number, currency, balance, credit_limit, available_credit_limit, status, created_at, updated_at;
+	`
+)
+
+// expiredHold holds the IDs of a hold past its expiry.
+type expiredHold struct {
+	HoldID    int `db:"hold_id"`
+	AccountID int `db:"account_id"`
+}
+
+// holdStatus is the audited state of an expired hold.
+type holdStatus struct {
+	HoldID int    `json:"hold_id"`
+	Status string `json:"status"`
+}
+
+// CreateHold reserves the amount of the available credit limit of the account for a debit of the operation type,
+// until the hold is captured, voided or expired.
+func (dr *dataRepo) CreateHold(ctx context.Context, req CreateHoldReqParams) (*HoldResponse, error) {
+	var res HoldResponse
+	err := dr.execTxn(ctx, func(tx *sqlx.Tx, audit *auditTrail) error {
+		// Validate the account and operation type as for a transaction
+		var validation validateCreateTrx
+		if err := tx.GetContext(
+			ctx,
+			&validation,
+			validateCreateTrxQuery,
+			req.AccountID,
+			req.OperationTypeID,
+		); err != nil {
+			return err
+		}
+
+		if !validation.IsAccountExists {
+			return ErrAccountIDNotExists
+		}
+
+		if !validation.IsOperationTypeIDExists {
+			return ErrOperationTypeIDNotExists
+		}
+
+		if !validation.IsOperationTypeActive {
+			return ErrOperationTypeInactive
+		}
+
+		if validation.IsSystemOperationType {
+			return ErrSystemOperationType
+		}
+
+		if validation.OperationSign >= 0 {
+			return ErrHoldNotDebit
+		}
+
+		// A hold is captured into a single debit, it can't be paid in installments
+		if validation.AllowsInstallments {
+			return ErrHoldInstallmentsNotAllowed
+		}
+
+		if req.Amount.Currency != validation.AccountCurrency {
+			return ErrCurrencyMismatch
+		}
+
+		// Lock the account so that concurrent holds and debits can't exceed the credit limit together
+		account, err := lockAccount(ctx, tx, req.AccountID)
+		if err != nil {
+			return err
+		}
+
+		// Check the status on the locked row, it may have changed while waiting for the lock
+		if err := checkAccountStatus(account.Status); err != nil {
+			return err
+		}
+
+		if req.Amount.Amount > account.AvailableCreditLimit {
+			return ErrInsufficientCreditLimit
+		}
+
+		if err := tx.GetContext(
+			ctx,
+			&res,
+			createHoldQuery,
+			req.AccountID,
+			req.OperationTypeID,
+			req.Amount.Amount,
+			req.Amount.Currency,
+			req.TTL.Microseconds(),
+		); err != nil {
+			return err
+		}
+		audit.record(AuditEntityHold, res.HoldID, AuditActionCreated, nil, res)
+
+		_, err = updateAccountAvailableCreditLimit(ctx, tx, audit, *account, -res.Amount)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// CaptureHold captures the whole or part of the held amount into a debit transaction of the operation type
+// of the hold, which must still be an active debit type without installments. The held amount is released,
+// the part not captured is available again.
+// sql.ErrNoRows is returned when the hold doesn't exist.
+func (dr *dataRepo) CaptureHold(ctx context.Context, req CaptureHoldReqParams) (*HoldCaptureResponse, error) {
+	var res HoldCaptureResponse
+	err := dr.execTxn(ctx, func(tx *sqlx.Tx, audit *auditTrail) error {
+		account, hold, err := lockHold(ctx, tx, req.HoldID)
+		if err != nil {
+			return err
+		}
+
+		if hold.Status != HoldStatusActive {
+			return ErrHoldNotActive
+		}
+
+		if hold.IsExpired {
+			return ErrHoldExpired
+		}
+
+		if err := checkAccountStatus(account.Status); err != nil {
+			return err
+		}
+
+		captured := hold.Money()
+		if req.Amount != nil {
+			if req.Amount.Currency != hold.Currency {
+				return ErrCurrencyMismatch
+			}
+			if req.Amount.Amount > hold.Amount {
+				return ErrCaptureExceedsHold
+			}
+			captured = *req.Amount
+		}
+
+		// The operation type may have changed since the hold was created
+		var operationType operationTypeSign
+		if err := tx.GetContext(
+			ctx,
+			&operationType,
+			getHoldOperationTypeQuery,
+			hold.OperationTypeID,
+		); err != nil {
+			return err
+		}
+		if err := checkHoldOperationType(operationType); err != nil {
+			return err
+		}
+
+		// Release the held amount, the captured part is then used up by the debit
+		account, err = updateAccountAvailableCreditLimit(ctx, tx, audit, *account, hold.Amount)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.GetContext(
+			ctx,
+			&res.Transaction,
+			createTransactionQuery,
+			hold.AccountID,
+			hold.OperationTypeID,
+			-captured.Amount,
+			captured.Currency,
+			nil,
+		); err != nil {
+			return err
+		}
+		audit.record(AuditEntityTransaction, res.Transaction.TransactionID, AuditActionCreated, nil, res.Transaction)
+
+		// Record where the money came from and went to in the ledger
+		if err := postJournals(ctx, tx, []journal{transactionJournal(res.Transaction)}); err != nil {
+			return err
+		}
+
+		// Keep the materialized account balance and credit limit consistent with the transactions
+		if _, err := updateAccountBalance(ctx, tx, audit, *account, res.Transaction.Amount); err != nil {
+			return err
+		}
+
+		if err := tx.GetContext(
+			ctx,
+			&res.Hold,
+			captureHoldQuery,
+			hold.HoldID,
+			captured.Amount,
+			res.Transaction.TransactionID,
+		); err != nil {
+			return err
+		}
+		audit.record(AuditEntityHold, res.Hold.HoldID, AuditActionCaptured, hold.HoldResponse, res.Hold)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// checkHoldOperationType returns the error a hold of the operation type can't be captured with,
+// nil when it can be booked as a debit of the operation type.
+func checkHoldOperationType(operationType operationTypeSign) error {
+	switch {
+	case !operationType.IsActive:
+		return ErrOperationTypeInactive
+	case operationType.IsSystem:
+		return ErrSystemOperationType
+	case operationType.Sign >= 0:
+		return ErrHoldNotDebit
+	case operationType.AllowsInstallments:
+		return ErrHoldInstallmentsNotAllowed
+	default:
+		return nil
+	}
+}
+
+// VoidHold releases the held amount without capturing it.
+// sql.ErrNoRows is returned when the hold doesn't exist.
+func (dr *dataRepo) VoidHold(ctx context.Context, holdID int) (*HoldResponse, error) {
+	var res HoldResponse
+	err := dr.execTxn(ctx, func(tx *sqlx.Tx, audit *auditTrail) error {
+		account, hold, err := lockHold(ctx, tx, holdID)
+		if err != nil {
+			return err
+		}
+
+		if hold.Status != HoldStatusActive {
+			return ErrHoldNotActive
+		}
+
+		if _, err := updateAccountAvailableCreditLimit(ctx, tx, audit, *account, hold.Amount); err != nil {
+			return err
+		}
+
+		if err := tx.GetContext(
+			ctx,
+			&res,
+			voidHoldQuery,
+			hold.HoldID,
+		); err != nil {
+			return err
+		}
+		audit.record(AuditEntityHold, res.HoldID, AuditActionVoided, hold.HoldResponse, res)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ExpireHolds expires up to limit active holds past their expiry, releasing their held amounts,
+// and returns the number of holds expired.
+func (dr *dataRepo) ExpireHolds(ctx context.Context, limit int) (int, error) {
+	var expired []HoldResponse
+	err := dr.execTxn(ctx, func(tx *sqlx.Tx, audit *auditTrail) error {
+		candidates := []expiredHold{}
+		if err := tx.SelectContext(
+			ctx,
+			&candidates,
+			listExpiredHoldsQuery,
+			limit,
+		); err != nil {
+			return err
+		}
+		if len(candidates) == 0 {
+			return nil
+		}
+
+		holdIDs := make([]int64, len(candidates))
+		accountIDs := make([]int64, len(candidates))
+		for i, candidate := range candidates {
+			holdIDs[i] = int64(candidate.HoldID)
+			accountIDs[i] = int64(candidate.AccountID)
+		}
+
+		// Lock the accounts of the holds in account ID order before the holds, as captures and voids do
+		accounts := []AccountResponse{}
+		if err := tx.SelectContext(
+			ctx,
+			&accounts,
+			lockAccountsQuery,
+			pq.Array(accountIDs),
+		); err != nil {
+			return err
+		}
+
+		if err := tx.SelectContext(
+			ctx,
+			&expired,
+			expireHoldsQuery,
+			pq.Array(holdIDs),
+		); err != nil {
+			return err
+		}
+		sort.Slice(expired, func(i, j int) bool {
+			return expired[i].HoldID < expired[j].HoldID
+		})
+
+		released := make(map[int]int64)
+		for _, hold := range expired {
+			released[hold.AccountID] += hold.Amount
+			audit.record(
+				AuditEntityHold,
+				hold.HoldID,
+				AuditActionExpired,
+				holdStatus{HoldID: hold.HoldID, Status: HoldStatusActive},
+				holdStatus{HoldID: hold.HoldID, Status: hold.Status},
+			)
+		}
+
+		// Release the held amounts, updating the accounts in lock order
+		for _, account := range accounts {
+			amount, ok := released[account.AccountID]
+			if !ok {
+				continue
+			}
+
+			if _, err := updateAccountAvailableCreditLimit(ctx, tx, audit, account, amount); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(expired), nil
+}
+
+// updateAccountAvailableCreditLimit reserves or releases the available credit limit of the locked account
+// by the amount, records the change in the audit trail, and returns the updated account.
+func updateAccountAvailableCreditLimit(ctx context.Context, tx *sqlx.Tx, audit *auditTrail, account AccountResponse, amount int64) (*AccountResponse, error) {
+	return changeLockedAccount(ctx, tx, audit, account, updateAccountAvailableCreditLimitQuery, amount)
+}
+
+// lockHold returns the hold and its account, both locked for update until the end of the transaction.
+// The account is locked first, in the same order as the transactions of the account.
+func lockHold(ctx context.Context, tx *sqlx.Tx, holdID int) (*AccountResponse, *lockedHold, error) {
+	var accountID int
+	if err := tx.GetContext(
+		ctx,
+		&accountID,
+		getHoldAccountIDQuery,
+		holdID,
+	); err != nil {
+		return nil, nil, err
+	}
+
+	account, err := lockAccount(ctx, tx, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var hold lockedHold
+	if err := tx.GetContext(
+		ctx,
+		&hold,
+		lockHoldQuery,
+		holdID,
+	); err != nil {
+		return nil, nil, err
+	}
+
+	return account, &hold, nil
+}
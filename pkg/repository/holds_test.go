@@ -0,0 +1,484 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/suite"
+)
+
+// testHoldsSuite is a test suite object to test the authorization holds.
+type testHoldsSuite struct {
+	suite.Suite
+
+	db   *sqlx.DB
+	mock sqlmock.Sqlmock
+	repo DataRepo
+}
+
+// SetupTest setups and initializes the testHoldsSuite.
+func (s *testHoldsSuite) SetupTest() {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	s.Require().NoError(err)
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+
+	s.db = sqlxDB
+	s.mock = mock
+	s.repo = NewDataRepo(sqlxDB)
+}
+
+// TearDownTest gracefully closes the test suite, by closing the db connection.
+func (s *testHoldsSuite) TearDownTest() {
+	if s.db != nil {
+		err := s.db.Close()
+		if err != nil {
+			return
+		}
+	}
+}
+
+// TestHoldsSuite is the custom test suite to test the authorization holds.
+func TestHoldsSuite(t *testing.T) {
+	suite.Run(t, new(testHoldsSuite))
+}
+
+// holdColumns are the columns of a Hold row.
+var holdColumns = []string{"hold_id", "account_id", "operation_type_id", "amount", "captured_amount", "currency", "status", "transaction_id", "expires_at", "created_at", "updated_at"}
+
+// holdRows returns the mocked rows for the given Hold data.
+func holdRows(holds ...HoldResponse) *sqlmock.Rows {
+	rows := sqlmock.NewRows(holdColumns)
+	for _, hold := range holds {
+		rows.AddRow(hold.HoldID, hold.AccountID, hold.OperationTypeID, hold.Amount, hold.CapturedAmount, hold.Currency,
+			hold.Status, hold.TransactionID, hold.ExpiresAt, hold.CreatedAt, hold.UpdatedAt)
+	}
+	return rows
+}
+
+// activeHold returns an active hold of 50.00 BRL on account 1.
+func activeHold() HoldResponse {
+	t := time.Date(2025, 2, 17, 9, 0, 0, 0, time.UTC)
+	return HoldResponse{
+		HoldID:          5,
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          5000,
+		Currency:        "BRL",
+		Status:          HoldStatusActive,
+		ExpiresAt:       t.Add(7 * 24 * time.Hour),
+		CreatedAt:       t,
+		UpdatedAt:       t,
+	}
+}
+
+// expectLockHold sets the expectations to lock the account and the given hold.
+func (s *testHoldsSuite) expectLockHold(hold HoldResponse, isExpired bool, accountStatus string) {
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(getHoldAccountIDQuery).
+		WithArgs(hold.HoldID).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id"}).AddRow(hold.AccountID))
+
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(hold.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: hold.AccountID, Currency: "BRL", AvailableCreditLimit: 5000, Status: accountStatus}))
+
+	s.mock.ExpectQuery(lockHoldQuery).
+		WithArgs(hold.HoldID).
+		WillReturnRows(sqlmock.NewRows(append(holdColumns, "is_expired")).
+			AddRow(hold.HoldID, hold.AccountID, hold.OperationTypeID, hold.Amount, hold.CapturedAmount, hold.Currency,
+				hold.Status, hold.TransactionID, hold.ExpiresAt, hold.CreatedAt, hold.UpdatedAt, isExpired))
+}
+
+// expectHoldOperationType sets the expectation to read the operation type of the hold captured.
+func (s *testHoldsSuite) expectHoldOperationType(operationType operationTypeSign) {
+	s.mock.ExpectQuery(getHoldOperationTypeQuery).
+		WithArgs(operationType.OperationTypeID).
+		WillReturnRows(sqlmock.NewRows([]string{"operation_type_id", "sign", "is_active", "allows_installments", "is_system"}).
+			AddRow(operationType.OperationTypeID, operationType.Sign, operationType.IsActive, operationType.AllowsInstallments, operationType.IsSystem))
+}
+
+// @Success testcase
+func (s *testHoldsSuite) TestCreateHoldSuccess() {
+	req := CreateHoldReqParams{
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          Money{Amount: 5000, Currency: "BRL"},
+		TTL:             7 * 24 * time.Hour,
+	}
+	expected := activeHold()
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(validateCreateTrxQuery).
+		WithArgs(req.AccountID, req.OperationTypeID).
+		WillReturnRows(validateCreateTrxRows(validateCreateTrx{
+			IsAccountExists:         true,
+			IsOperationTypeIDExists: true,
+			IsOperationTypeActive:   true,
+			OperationSign:           -1,
+			AccountCurrency:         "BRL",
+		}))
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID, Currency: "BRL", AvailableCreditLimit: 5000, Status: AccountStatusActive}))
+	s.mock.ExpectQuery(createHoldQuery).
+		WithArgs(req.AccountID, req.OperationTypeID, int64(5000), "BRL", int64(604800000000)).
+		WillReturnRows(holdRows(expected))
+
+	// The held amount is reserved from the available credit limit
+	s.mock.ExpectQuery(updateAccountAvailableCreditLimitQuery).
+		WithArgs(req.AccountID, int64(-5000)).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID}))
+
+	expectAuditEntries(s.mock, []string{AuditEntityHold, AuditEntityAccount}, []int64{5, 1}, []string{AuditActionCreated, AuditActionUpdated})
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.CreateHold(context.Background(), req)
+	s.Require().NoError(err)
+	s.Equal(&expected, actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testHoldsSuite) TestCreateHoldNotDebit() {
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(validateCreateTrxQuery).
+		WithArgs(1, 4).
+		WillReturnRows(validateCreateTrxRows(validateCreateTrx{
+			IsAccountExists:         true,
+			IsOperationTypeIDExists: true,
+			IsOperationTypeActive:   true,
+			OperationSign:           1,
+			AccountCurrency:         "BRL",
+		}))
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CreateHold(context.Background(), CreateHoldReqParams{
+		AccountID:       1,
+		OperationTypeID: 4,
+		Amount:          Money{Amount: 5000, Currency: "BRL"},
+		TTL:             time.Hour,
+	})
+	s.ErrorIs(err, ErrHoldNotDebit)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testHoldsSuite) TestCreateHoldInstallmentsNotAllowed() {
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(validateCreateTrxQuery).
+		WithArgs(1, 2).
+		WillReturnRows(validateCreateTrxRows(validateCreateTrx{
+			IsAccountExists:         true,
+			IsOperationTypeIDExists: true,
+			IsOperationTypeActive:   true,
+			OperationSign:           -1,
+			AllowsInstallments:      true,
+			AccountCurrency:         "BRL",
+		}))
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CreateHold(context.Background(), CreateHoldReqParams{
+		AccountID:       1,
+		OperationTypeID: 2,
+		Amount:          Money{Amount: 5000, Currency: "BRL"},
+		TTL:             time.Hour,
+	})
+	s.ErrorIs(err, ErrHoldInstallmentsNotAllowed)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testHoldsSuite) TestCreateHoldInsufficientCreditLimit() {
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(validateCreateTrxQuery).
+		WithArgs(1, 1).
+		WillReturnRows(validateCreateTrxRows(validateCreateTrx{
+			IsAccountExists:         true,
+			IsOperationTypeIDExists: true,
+			IsOperationTypeActive:   true,
+			OperationSign:           -1,
+			AccountCurrency:         "BRL",
+		}))
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(1).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: 1, Currency: "BRL", AvailableCreditLimit: 4999, Status: AccountStatusActive}))
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CreateHold(context.Background(), CreateHoldReqParams{
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          Money{Amount: 5000, Currency: "BRL"},
+		TTL:             time.Hour,
+	})
+	s.ErrorIs(err, ErrInsufficientCreditLimit)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testHoldsSuite) TestCreateHoldAccountBlockedWhileLocking() {
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(validateCreateTrxQuery).
+		WithArgs(1, 1).
+		WillReturnRows(validateCreateTrxRows(validateCreateTrx{
+			IsAccountExists:         true,
+			IsOperationTypeIDExists: true,
+			IsOperationTypeActive:   true,
+			OperationSign:           -1,
+			AccountCurrency:         "BRL",
+		}))
+
+	// The account is blocked while the request waits for its lock
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(1).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: 1, Currency: "BRL", AvailableCreditLimit: 5000, Status: AccountStatusBlocked}))
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CreateHold(context.Background(), CreateHoldReqParams{
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          Money{Amount: 5000, Currency: "BRL"},
+		TTL:             time.Hour,
+	})
+	s.ErrorIs(err, ErrAccountBlocked)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Success testcase
+func (s *testHoldsSuite) TestCaptureHoldPartially() {
+	hold := activeHold()
+	s.expectLockHold(hold, false, AccountStatusActive)
+	s.expectHoldOperationType(operationTypeSign{OperationTypeID: 1, Sign: -1, IsActive: true})
+
+	// The held amount is released and the captured part is booked as a debit
+	s.mock.ExpectQuery(updateAccountAvailableCreditLimitQuery).
+		WithArgs(hold.AccountID, int64(5000)).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: hold.AccountID}))
+
+	t := time.Now()
+	trx := TransactionResponse{TransactionID: 30, AccountID: 1, OperationTypeID: 1, Amount: -4200, Currency: "BRL", Balance: -4200, Status: TransactionStatusPosted, EventDate: t, CreatedAt: t, UpdatedAt: t}
+	s.mock.ExpectQuery(createTransactionQuery).
+		WithArgs(hold.AccountID, hold.OperationTypeID, int64(-4200), "BRL", nil).
+		WillReturnRows(transactionRows(&trx))
+
+	expectJournals(s.mock, trx)
+
+	s.mock.ExpectQuery(updateAccountBalanceQuery).
+		WithArgs(hold.AccountID, int64(-4200)).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: hold.AccountID}))
+
+	captured := hold
+	captured.Status = HoldStatusCaptured
+	captured.CapturedAmount = 4200
+	captured.TransactionID = &trx.TransactionID
+	s.mock.ExpectQuery(captureHoldQuery).
+		WithArgs(hold.HoldID, int64(4200), trx.TransactionID).
+		WillReturnRows(holdRows(captured))
+
+	expectAuditEntries(s.mock, []string{AuditEntityAccount, AuditEntityTransaction, AuditEntityAccount, AuditEntityHold}, []int64{1, 30, 1, 5}, []string{AuditActionUpdated, AuditActionCreated, AuditActionUpdated, AuditActionCaptured})
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.CaptureHold(context.Background(), CaptureHoldReqParams{
+		HoldID: hold.HoldID,
+		Amount: &Money{Amount: 4200, Currency: "BRL"},
+	})
+	s.Require().NoError(err)
+	s.Equal(&HoldCaptureResponse{Hold: captured, Transaction: trx}, actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testHoldsSuite) TestCaptureHoldOperationTypeChanged() {
+	testCases := []struct {
+		operationType operationTypeSign
+		err           error
+	}{
+		{operationTypeSign{OperationTypeID: 1, Sign: -1, IsActive: false}, ErrOperationTypeInactive},
+		{operationTypeSign{OperationTypeID: 1, Sign: 1, IsActive: true}, ErrHoldNotDebit},
+		{operationTypeSign{OperationTypeID: 1, Sign: -1, IsActive: true, AllowsInstallments: true}, ErrHoldInstallmentsNotAllowed},
+	}
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		hold := activeHold()
+		s.expectLockHold(hold, false, AccountStatusActive)
+		s.expectHoldOperationType(tc.operationType)
+
+		// Nothing is captured with the operation type as it's now
+		s.mock.ExpectRollback()
+
+		actual, err := s.repo.CaptureHold(context.Background(), CaptureHoldReqParams{HoldID: hold.HoldID})
+		s.ErrorIs(err, tc.err)
+		s.Nil(actual)
+		s.Require().NoError(s.mock.ExpectationsWereMet())
+	}
+}
+
+// @Failed testcase
+func (s *testHoldsSuite) TestCaptureHoldNotFound() {
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(getHoldAccountIDQuery).
+		WithArgs(5).
+		WillReturnError(sql.ErrNoRows)
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CaptureHold(context.Background(), CaptureHoldReqParams{HoldID: 5})
+	s.ErrorIs(err, sql.ErrNoRows)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testHoldsSuite) TestCaptureHoldNotActive() {
+	hold := activeHold()
+	hold.Status = HoldStatusVoided
+	s.expectLockHold(hold, false, AccountStatusActive)
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CaptureHold(context.Background(), CaptureHoldReqParams{HoldID: hold.HoldID})
+	s.ErrorIs(err, ErrHoldNotActive)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testHoldsSuite) TestCaptureHoldExpired() {
+	hold := activeHold()
+	s.expectLockHold(hold, true, AccountStatusActive)
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CaptureHold(context.Background(), CaptureHoldReqParams{HoldID: hold.HoldID})
+	s.ErrorIs(err, ErrHoldExpired)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testHoldsSuite) TestCaptureHoldExceedsHold() {
+	hold := activeHold()
+	s.expectLockHold(hold, false, AccountStatusActive)
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CaptureHold(context.Background(), CaptureHoldReqParams{
+		HoldID: hold.HoldID,
+		Amount: &Money{Amount: 5001, Currency: "BRL"},
+	})
+	s.ErrorIs(err, ErrCaptureExceedsHold)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Success testcase
+func (s *testHoldsSuite) TestVoidHoldSuccess() {
+	hold := activeHold()
+	s.expectLockHold(hold, false, AccountStatusBlocked)
+
+	s.mock.ExpectQuery(updateAccountAvailableCreditLimitQuery).
+		WithArgs(hold.AccountID, int64(5000)).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: hold.AccountID}))
+
+	voided := hold
+	voided.Status = HoldStatusVoided
+	s.mock.ExpectQuery(voidHoldQuery).
+		WithArgs(hold.HoldID).
+		WillReturnRows(holdRows(voided))
+
+	expectAuditEntries(s.mock, []string{AuditEntityAccount, AuditEntityHold}, []int64{1, 5}, []string{AuditActionUpdated, AuditActionVoided})
+	s.mock.ExpectCommit()
+
+	// A hold of a blocked account can still be voided
+	actual, err := s.repo.VoidHold(context.Background(), hold.HoldID)
+	s.Require().NoError(err)
+	s.Equal(&voided, actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testHoldsSuite) TestVoidHoldNotActive() {
+	hold := activeHold()
+	hold.Status = HoldStatusCaptured
+	s.expectLockHold(hold, false, AccountStatusActive)
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.VoidHold(context.Background(), hold.HoldID)
+	s.ErrorIs(err, ErrHoldNotActive)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Success testcase
+func (s *testHoldsSuite) TestExpireHolds() {
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(listExpiredHoldsQuery).
+		WithArgs(100).
+		WillReturnRows(sqlmock.NewRows([]string{"hold_id", "account_id"}).
+			AddRow(5, 2).
+			AddRow(6, 1).
+			AddRow(7, 2),
+		)
+	s.mock.ExpectQuery(lockAccountsQuery).
+		WithArgs(pq.Array([]int64{2, 1, 2})).
+		WillReturnRows(accountRows(
+			&AccountResponse{AccountID: 1, Currency: "BRL", Status: AccountStatusActive},
+			&AccountResponse{AccountID: 2, Currency: "BRL", Status: AccountStatusActive},
+		))
+
+	// Hold 6 was captured before its account was locked, so it isn't expired
+	first, second := activeHold(), activeHold()
+	first.AccountID, first.Status = 2, HoldStatusExpired
+	second.HoldID, second.AccountID, second.Amount, second.Status = 7, 2, 1500, HoldStatusExpired
+	s.mock.ExpectQuery(expireHoldsQuery).
+		WithArgs(pq.Array([]int64{5, 6, 7})).
+		WillReturnRows(holdRows(second, first))
+
+	s.mock.ExpectQuery(updateAccountAvailableCreditLimitQuery).
+		WithArgs(2, int64(6500)).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: 2}))
+
+	expectAuditEntries(s.mock, []string{AuditEntityHold, AuditEntityHold, AuditEntityAccount}, []int64{5, 7, 2}, []string{AuditActionExpired, AuditActionExpired, AuditActionUpdated})
+	s.mock.ExpectCommit()
+
+	expired, err := s.repo.ExpireHolds(context.Background(), 100)
+	s.Require().NoError(err)
+	s.Equal(2, expired)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Success testcase
+func (s *testHoldsSuite) TestExpireHoldsNone() {
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(listExpiredHoldsQuery).
+		WithArgs(100).
+		WillReturnRows(sqlmock.NewRows([]string{"hold_id", "account_id"}))
+	s.mock.ExpectCommit()
+
+	expired, err := s.repo.ExpireHolds(context.Background(), 100)
+	s.Require().NoError(err)
+	s.Equal(0, expired)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testHoldsSuite) TestExpireHoldsError() {
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(listExpiredHoldsQuery).
+		WithArgs(100).
+		WillReturnError(errors.New("something went wrong"))
+	s.mock.ExpectRollback()
+
+	expired, err := s.repo.ExpireHolds(context.Background(), 100)
+	s.Require().Error(err)
+	s.Equal(0, expired)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
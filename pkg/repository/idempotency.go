@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	claimIdempotencyKeyQuery = `INSERT INTO idempotency_keys (idempotency_key, request_hash) VALUES ($1, $2) ON CONFLICT (idempotency_key) DO NOTHING;`
+
+	getIdempotencyKeyQuery = `SELECT request_hash, response FROM idempotency_keys WHERE idempotency_key=$1;`
+
+	setIdempotencyKeyResponseQuery = `UPDATE idempotency_keys SET transaction_id=$2, response=$3::JSONB WHERE idempotency_key=$1;`
+)
+
+// idempotencyKey holds a stored idempotency key.
+// Response is the JSON encoded transaction returned to the request that used the key.
+type idempotencyKey struct {
+	RequestHash string `db:"request_hash"`
+	Response    []byte `db:"response"`
+}
+
+// claimIdempotencyKey stores the idempotency key of the request inside the given db transaction.
+// It returns the transaction as returned to the original request when the key was already used
+// with the same request, and ErrIdempotencyKeyConflict when it was used with a different request.
+// A concurrent request with the same key waits on the insert until the first one completes.
+func claimIdempotencyKey(ctx context.Context, tx *sqlx.Tx, key, requestHash string) (*TransactionResponse, error) {
+	result, err := tx.ExecContext(
+		ctx,
+		claimIdempotencyKeyQuery,
+		key,
+		requestHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if claimed == 1 {
+		return nil, nil
+	}
+
+	// The key was already used, replay the original response if the request matches
+	var stored idempotencyKey
+	if err := tx.GetContext(
+		ctx,
+		&stored,
+		getIdempotencyKeyQuery,
+		key,
+	); err != nil {
+		return nil, err
+	}
+
+	if stored.RequestHash != requestHash || stored.Response == nil {
+		return nil, ErrIdempotencyKeyConflict
+	}
+
+	var res TransactionResponse
+	if err := json.Unmarshal(stored.Response, &res); err != nil {
+		return nil, fmt.Errorf("failed to decode the stored idempotent response: %w", err)
+	}
+	res.Replayed = true
+
+	return &res, nil
+}
+
+// setIdempotencyKeyResponse links the idempotency key to the transaction created for it,
+// keeping the transaction as returned to the request for its retries.
+func setIdempotencyKeyResponse(ctx context.Context, tx *sqlx.Tx, key string, res TransactionResponse) error {
+	response, err := json.Marshal(res)
+	if err != nil {
+		return fmt.Errorf("failed to encode the idempotent response: %w", err)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		setIdempotencyKeyResponseQuery,
+		key,
+		res.TransactionID,
+		string(response),
+	)
+	return err
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Installment statuses, derived from the settled amount of the parent transaction
+// with the installments paid in order. All installments of a reversed transaction are reversed.
+const (
+	InstallmentStatusPaid          = "paid"
+	InstallmentStatusPartiallyPaid = "partially_paid"
+	InstallmentStatusUnpaid        = "unpaid"
+	InstallmentStatusReversed      = "reversed"
+)
+
+const (
+	createInstallmentsQuery = `
+	INSERT INTO installments (transaction_id, number, due_date, amount, currency)
+	SELECT $1, UNNEST($2::INT[]), UNNEST($3::DATE[]), UNNEST($4::BIGINT[]), $5;
+	`
+
+	getInstallmentTransactionQuery = `SELECT transaction_id, amount, balance, status FROM transactions WHERE transaction_id=$1;`
+
+	listInstallmentsQuery = `
+	SELECT transaction_id, number, due_date, amount, currency
+	FROM installments
+	WHERE transaction_id=$1
+	ORDER BY number;
+	`
+)
+
+// installmentTransaction holds the amounts of the parent transaction of installments.
+type installmentTransaction struct {
+	TransactionID int    `db:"transaction_id"`
+	Amount        int64  `db:"amount"`
+	Balance       int64  `db:"balance"`
+	Status        string `db:"status"`
+}
+
+// ListInstallments returns the installments of the transaction ordered by number.
+// sql.ErrNoRows is returned when the transaction doesn't exist.
+func (dr *dataRepo) ListInstallments(ctx context.Context, transactionID int) ([]InstallmentResponse, error) {
+	var trx installmentTransaction
+	if err := dr.db.GetContext(
+		ctx,
+		&trx,
+		getInstallmentTransactionQuery,
+		transactionID,
+	); err != nil {
+		return nil, err
+	}
+
+	installments := []InstallmentResponse{}
+	if err := dr.db.SelectContext(
+		ctx,
+		&installments,
+		listInstallmentsQuery,
+		transactionID,
+	); err != nil {
+		return nil, err
+	}
+
+	if trx.Status == TransactionStatusReversed {
+		for i := range installments {
+			installments[i].Status = InstallmentStatusReversed
+		}
+		return installments, nil
+	}
+
+	setInstallmentStatuses(installments, trx.Amount-trx.Balance)
+	return installments, nil
+}
+
+// checkInstallments returns ErrInstallmentsExceedAmount when the amount can't be split into n installments
+// of at least one minor unit each, as an installment of zero would be paid before anything is settled.
+func checkInstallments(amount Money, n int) error {
+	if int64(n) > amount.Abs().Amount {
+		return ErrInstallmentsExceedAmount
+	}
+	return nil
+}
+
+// createInstallments splits the transaction amount into n monthly installments, the
+// first one due a month after the event date. The remainder cents go to the first installment.
+func createInstallments(ctx context.Context, tx *sqlx.Tx, trx *TransactionResponse, n int) error {
+	numbers := make([]int64, n)
+	dueDates := make([]string, n)
+	amounts := make([]int64, n)
+	for i, part := range trx.Money().Split(n) {
+		numbers[i] = int64(i + 1)
+		dueDates[i] = addMonths(trx.EventDate, i+1).Format(time.DateOnly)
+		amounts[i] = part.Amount
+	}
+
+	_, err := tx.ExecContext(
+		ctx,
+		createInstallmentsQuery,
+		trx.TransactionID,
+		pq.Array(numbers),
+		pq.Array(dueDates),
+		pq.Array(amounts),
+		trx.Currency,
+	)
+	return err
+}
+
+// setInstallmentStatuses sets the status of the installments, paying them in order with the
+// settled amount of their transaction.
+func setInstallmentStatuses(installments []InstallmentResponse, settled int64) {
+	if settled < 0 {
+		settled = -settled
+	}
+
+	for i := range installments {
+		amount := installments[i].Money().Abs().Amount
+		switch {
+		case settled >= amount:
+			installments[i].Status = InstallmentStatusPaid
+		case settled > 0:
+			installments[i].Status = InstallmentStatusPartiallyPaid
+		default:
+			installments[i].Status = InstallmentStatusUnpaid
+		}
+		settled = max(settled-amount, 0)
+	}
+}
+
+// addMonths adds the months to the date, clamping the day to the end of shorter months,
+// i.e. a month after January 31 is February 28 (or 29).
+func addMonths(date time.Time, months int) time.Time {
+	year, month, day := date.Date()
+	firstOfMonth := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, date.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	return firstOfMonth.AddDate(0, 0, min(day, lastDay)-1)
+}
@@ -0,0 +1,260 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/suite"
+)
+
+// testInstallmentsTableSuite is a test suite object to test database operations from Installments table.
+type testInstallmentsTableSuite struct {
+	suite.Suite
+
+	db   *sqlx.DB
+	mock sqlmock.Sqlmock
+	repo DataRepo
+}
+
+// SetupTest setups and initializes the testInstallmentsTableSuite.
+func (s *testInstallmentsTableSuite) SetupTest() {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	s.Require().NoError(err)
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+
+	s.db = sqlxDB
+	s.mock = mock
+	s.repo = NewDataRepo(sqlxDB)
+}
+
+// TearDownTest gracefully closes the test suite, by closing the db connection.
+func (s *testInstallmentsTableSuite) TearDownTest() {
+	if s.db != nil {
+		err := s.db.Close()
+		if err != nil {
+			return
+		}
+	}
+}
+
+// TestInstallmentsTableSuite is the custom test suite to test database operations from Installments table.
+func TestInstallmentsTableSuite(t *testing.T) {
+	suite.Run(t, new(testInstallmentsTableSuite))
+}
+
+// @Success testcase
+func (s *testInstallmentsTableSuite) TestListInstallmentsSuccess() {
+	transactionID := 1
+	dueDate := time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)
+
+	// 40.00 of the 100.01 purchase is settled
+	s.mock.ExpectQuery(getInstallmentTransactionQuery).
+		WithArgs(transactionID).
+		WillReturnRows(sqlmock.NewRows([]string{"transaction_id", "amount", "balance", "status"}).
+			AddRow(transactionID, -10001, -6001, TransactionStatusPosted),
+		)
+
+	s.mock.ExpectQuery(listInstallmentsQuery).
+		WithArgs(transactionID).
+		WillReturnRows(sqlmock.NewRows([]string{"transaction_id", "number", "due_date", "amount", "currency"}).
+			AddRow(transactionID, 1, dueDate, -3335, "BRL").
+			AddRow(transactionID, 2, dueDate.AddDate(0, 1, 0), -3333, "BRL").
+			AddRow(transactionID, 3, dueDate.AddDate(0, 2, 0), -3333, "BRL"),
+		)
+
+	actual, err := s.repo.ListInstallments(context.Background(), transactionID)
+	s.Require().NoError(err)
+	s.Require().Len(actual, 3)
+
+	s.Equal(InstallmentStatusPaid, actual[0].Status)
+	s.Equal(InstallmentStatusPartiallyPaid, actual[1].Status)
+	s.Equal(InstallmentStatusUnpaid, actual[2].Status)
+}
+
+// @Success testcase
+func (s *testInstallmentsTableSuite) TestListInstallmentsReversedTransaction() {
+	transactionID := 1
+	dueDate := time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)
+
+	s.mock.ExpectQuery(getInstallmentTransactionQuery).
+		WithArgs(transactionID).
+		WillReturnRows(sqlmock.NewRows([]string{"transaction_id", "amount", "balance", "status"}).
+			AddRow(transactionID, -10000, 0, TransactionStatusReversed),
+		)
+
+	s.mock.ExpectQuery(listInstallmentsQuery).
+		WithArgs(transactionID).
+		WillReturnRows(sqlmock.NewRows([]string{"transaction_id", "number", "due_date", "amount", "currency"}).
+			AddRow(transactionID, 1, dueDate, -5000, "BRL").
+			AddRow(transactionID, 2, dueDate.AddDate(0, 1, 0), -5000, "BRL"),
+		)
+
+	actual, err := s.repo.ListInstallments(context.Background(), transactionID)
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+
+	s.Equal(InstallmentStatusReversed, actual[0].Status)
+	s.Equal(InstallmentStatusReversed, actual[1].Status)
+}
+
+// @Failed testcase
+func (s *testInstallmentsTableSuite) TestListInstallmentsTransactionNotFound() {
+	s.mock.ExpectQuery(getInstallmentTransactionQuery).
+		WithArgs(1).
+		WillReturnError(sql.ErrNoRows)
+
+	actual, err := s.repo.ListInstallments(context.Background(), 1)
+	s.Require().ErrorIs(err, sql.ErrNoRows)
+
+	s.Require().Nil(actual)
+}
+
+// @Success testcase
+func (s *testInstallmentsTableSuite) TestCreateTransactionWithInstallments() {
+	req := CreateTransactionReqParams{
+		AccountID:       1,
+		OperationTypeID: 2,
+		Amount:          Money{Amount: 10001, Currency: "BRL"},
+		Installments:    3,
+	}
+	eventDate := time.Date(2025, time.January, 31, 10, 0, 0, 0, time.UTC)
+	req.EventDate = &eventDate
+	expected := &TransactionResponse{
+		TransactionID:   1,
+		AccountID:       req.AccountID,
+		OperationTypeID: req.OperationTypeID,
+		Amount:          -10001,
+		Currency:        "BRL",
+		Balance:         -10001,
+		EventDate:       eventDate,
+		CreatedAt:       eventDate,
+		UpdatedAt:       eventDate,
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(validateCreateTrxQuery).
+		WithArgs(req.AccountID, req.OperationTypeID).
+		WillReturnRows(validateCreateTrxRows(validateCreateTrx{
+			IsAccountExists:         true,
+			IsOperationTypeIDExists: true,
+			IsOperationTypeActive:   true,
+			OperationSign:           -1,
+			AllowsInstallments:      true,
+			AccountCurrency:         "BRL",
+		}))
+
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID, Currency: "BRL", AvailableCreditLimit: 100000}))
+
+	s.mock.ExpectQuery(createTransactionQuery).
+		WithArgs(req.AccountID, req.OperationTypeID, int64(-10001), "BRL", req.EventDate).
+		WillReturnRows(transactionRows(expected))
+
+	expectJournals(s.mock, *expected)
+
+	// The remainder cent goes to the first installment
+	s.mock.ExpectExec(createInstallmentsQuery).
+		WithArgs(
+			expected.TransactionID,
+			pq.Array([]int64{1, 2, 3}),
+			pq.Array([]string{"2025-02-28", "2025-03-31", "2025-04-30"}),
+			pq.Array([]int64{-3335, -3333, -3333}),
+			"BRL",
+		).WillReturnResult(sqlmock.NewResult(0, 3))
+
+	s.mock.ExpectQuery(updateAccountBalanceQuery).
+		WithArgs(expected.AccountID, expected.Amount).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: expected.AccountID}))
+
+	expectAuditEntries(s.mock, []string{AuditEntityTransaction, AuditEntityAccount}, []int64{int64(expected.TransactionID), 1}, []string{AuditActionCreated, AuditActionUpdated})
+
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.CreateTransaction(context.Background(), req)
+	s.Require().NoError(err)
+	s.Equal(expected, actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testInstallmentsTableSuite) TestCreateTransactionInstallmentsNotAllowed() {
+	req := CreateTransactionReqParams{
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          Money{Amount: 10001, Currency: "BRL"},
+		Installments:    3,
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(validateCreateTrxQuery).
+		WithArgs(req.AccountID, req.OperationTypeID).
+		WillReturnRows(validateCreateTrxRows(validateCreateTrx{
+			IsAccountExists:         true,
+			IsOperationTypeIDExists: true,
+			IsOperationTypeActive:   true,
+			OperationSign:           -1,
+			AccountCurrency:         "BRL",
+		}))
+
+	s.mock.ExpectRollback()
+
+	_, err := s.repo.CreateTransaction(context.Background(), req)
+	s.Require().ErrorIs(err, ErrInstallmentsNotAllowed)
+}
+
+// @Failed testcase
+func (s *testInstallmentsTableSuite) TestCreateTransactionInstallmentsExceedAmount() {
+	req := CreateTransactionReqParams{
+		AccountID:       1,
+		OperationTypeID: 2,
+		Amount:          Money{Amount: 5, Currency: "BRL"},
+		Installments:    48,
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(validateCreateTrxQuery).
+		WithArgs(req.AccountID, req.OperationTypeID).
+		WillReturnRows(validateCreateTrxRows(validateCreateTrx{
+			IsAccountExists:         true,
+			IsOperationTypeIDExists: true,
+			IsOperationTypeActive:   true,
+			OperationSign:           -1,
+			AccountCurrency:         "BRL",
+			AllowsInstallments:      true,
+		}))
+
+	// Installments of zero would be paid before anything is settled
+	s.mock.ExpectRollback()
+
+	_, err := s.repo.CreateTransaction(context.Background(), req)
+	s.Require().ErrorIs(err, ErrInstallmentsExceedAmount)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Success testcase
+func (s *testInstallmentsTableSuite) TestAddMonths() {
+	testcases := []struct {
+		date     time.Time
+		months   int
+		expected time.Time
+	}{
+		{time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC), 1, time.Date(2025, time.February, 15, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC), 1, time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC), 1, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2025, time.November, 30, 0, 0, 0, 0, time.UTC), 3, time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testcases {
+		s.Equal(tc.expected, addMonths(tc.date, tc.months), tc.date.String())
+	}
+}
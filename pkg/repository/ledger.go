@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Ledger account types. Customer ledger accounts belong to an account, the merchant settlement is kept per currency.
+const (
+	LedgerAccountTypeCustomer           = "customer"
+	LedgerAccountTypeMerchantSettlement = "merchant_settlement"
+)
+
+// Posting directions.
+const (
+	PostingDirectionDebit  = "debit"
+	PostingDirectionCredit = "credit"
+)
+
+const (
+	// The ledger accounts are created on their first posting, a system ledger account has no account ID
+	ensureLedgerAccountsQuery = `
+	INSERT INTO ledger_accounts (type, account_id, currency)
+	SELECT DISTINCT type, NULLIF(account_id, 0), currency
+	FROM UNNEST($1::TEXT[], $2::INT[], $3::TEXT[]) AS l(type, account_id, currency)
+	ON CONFLICT DO NOTHING;
+	`
+
+	createJournalsQuery = `
+	INSERT INTO journals (transaction_id)
+	SELECT UNNEST($1::INT[]);
+	`
+
+	createPostingsQuery = `
+	INSERT INTO postings (journal_id, ledger_account_id, direction, amount, currency)
+	SELECT j.journal_id, la.ledger_account_id, p.direction, p.amount, p.currency
+	FROM UNNEST($1::INT[], $2::TEXT[], $3::INT[], $4::TEXT[], $5::BIGINT[], $6::TEXT[])
+		WITH ORDINALITY AS p(transaction_id, type, account_id, direction, amount, currency, position)
+	JOIN journals j ON j.transaction_id = p.transaction_id
+	JOIN ledger_accounts la ON la.type = p.type
+		AND la.account_id IS NOT DISTINCT FROM NULLIF(p.account_id, 0)
+		AND la.currency = p.currency
+	ORDER BY p.position;
+	`
+)
+
+// journal is the balanced set of postings recording where the money of a transaction came from and went to.
+type journal struct {
+	TransactionID int
+	Postings      []posting
+}
+
+// posting is a debit or credit of a positive amount against a ledger account.
+// AccountID is only set for customer ledger accounts.
+type posting struct {
+	LedgerAccountType string
+	AccountID         int
+	Direction         string
+	Amount            Money
+}
+
+// transactionJournal returns the journal of a transaction between the customer and the merchant settlement.
+// A debit transaction debits the customer and a credit transaction credits it, so a reversal offsets
+// the journal of the transaction it reverses.
+func transactionJournal(trx TransactionResponse) journal {
+	customer, merchant := PostingDirectionCredit, PostingDirectionDebit
+	amount := trx.Amount
+	if amount < 0 {
+		customer, merchant = PostingDirectionDebit, PostingDirectionCredit
+		amount = -amount
+	}
+
+	return journal{
+		TransactionID: trx.TransactionID,
+		Postings: []posting{
+			{
+				LedgerAccountType: LedgerAccountTypeCustomer,
+				AccountID:         trx.AccountID,
+				Direction:         customer,
+				Amount:            Money{Amount: amount, Currency: trx.Currency},
+			},
+			{
+				LedgerAccountType: LedgerAccountTypeMerchantSettlement,
+				Direction:         merchant,
+				Amount:            Money{Amount: amount, Currency: trx.Currency},
+			},
+		},
+	}
+}
+
+// transferJournal returns the journal of a transfer, debiting the customer of the source account and crediting
+// the customer of the destination account. The money stays between customers, so the journal has no merchant
+// settlement posting. It is kept with the debit transaction, the credit transaction of a transfer has no journal.
+func transferJournal(debit, credit TransactionResponse) journal {
+	return journal{
+		TransactionID: debit.TransactionID,
+		Postings: []posting{
+			{
+				LedgerAccountType: LedgerAccountTypeCustomer,
+				AccountID:         debit.AccountID,
+				Direction:         PostingDirectionDebit,
+				Amount:            Money{Amount: -debit.Amount, Currency: debit.Currency},
+			},
+			{
+				LedgerAccountType: LedgerAccountTypeCustomer,
+				AccountID:         credit.AccountID,
+				Direction:         PostingDirectionCredit,
+				Amount:            Money{Amount: credit.Amount, Currency: credit.Currency},
+			},
+		},
+	}
+}
+
+// checkJournal returns ErrUnbalancedJournal unless the journal has at least two postings of positive amounts
+// and its debits equal its credits in each currency.
+func checkJournal(j journal) error {
+	if len(j.Postings) < 2 {
+		return ErrUnbalancedJournal
+	}
+
+	balances := map[string]int64{}
+	for _, p := range j.Postings {
+		if p.Amount.Amount <= 0 {
+			return ErrUnbalancedJournal
+		}
+
+		switch p.Direction {
+		case PostingDirectionDebit:
+			balances[p.Amount.Currency] += p.Amount.Amount
+		case PostingDirectionCredit:
+			balances[p.Amount.Currency] -= p.Amount.Amount
+		default:
+			return ErrUnbalancedJournal
+		}
+	}
+
+	for _, balance := range balances {
+		if balance != 0 {
+			return ErrUnbalancedJournal
+		}
+	}
+
+	return nil
+}
+
+// postJournals checks and writes the journals with their postings in the database transaction.
+// The database rejects an unbalanced journal as well when the transaction commits.
+func postJournals(ctx context.Context, tx *sqlx.Tx, journals []journal) error {
+	if len(journals) == 0 {
+		return nil
+	}
+
+	transactionIDs := make([]int64, 0, len(journals))
+	var (
+		postingTrxIDs     []int64
+		postingTypes      []string
+		postingAccountIDs []int64
+		postingDirections []string
+		postingAmounts    []int64
+		postingCurrencies []string
+	)
+	for _, j := range journals {
+		if err := checkJournal(j); err != nil {
+			return err
+		}
+
+		transactionIDs = append(transactionIDs, int64(j.TransactionID))
+		for _, p := range j.Postings {
+			postingTrxIDs = append(postingTrxIDs, int64(j.TransactionID))
+			postingTypes = append(postingTypes, p.LedgerAccountType)
+			postingAccountIDs = append(postingAccountIDs, int64(p.AccountID))
+			postingDirections = append(postingDirections, p.Direction)
+			postingAmounts = append(postingAmounts, p.Amount.Amount)
+			postingCurrencies = append(postingCurrencies, p.Amount.Currency)
+		}
+	}
+
+	if _, err := tx.ExecContext(
+		ctx,
+		ensureLedgerAccountsQuery,
+		pq.Array(postingTypes),
+		pq.Array(postingAccountIDs),
+		pq.Array(postingCurrencies),
+	); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(
+		ctx,
+		createJournalsQuery,
+		pq.Array(transactionIDs),
+	); err != nil {
+		return err
+	}
+
+	_, err := tx.ExecContext(
+		ctx,
+		createPostingsQuery,
+		pq.Array(postingTrxIDs),
+		pq.Array(postingTypes),
+		pq.Array(postingAccountIDs),
+		pq.Array(postingDirections),
+		pq.Array(postingAmounts),
+		pq.Array(postingCurrencies),
+	)
+	return err
+}
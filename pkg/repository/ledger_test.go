@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/suite"
+)
+
+// testLedgerSuite is a test suite object to test the ledger journals and postings.
+type testLedgerSuite struct {
+	suite.Suite
+
+	db   *sqlx.DB
+	mock sqlmock.Sqlmock
+}
+
+// SetupTest setups and initializes the testLedgerSuite.
+func (s *testLedgerSuite) SetupTest() {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	s.Require().NoError(err)
+
+	s.db = sqlx.NewDb(db, "postgres")
+	s.mock = mock
+}
+
+// TearDownTest gracefully closes the test suite, by closing the db connection.
+func (s *testLedgerSuite) TearDownTest() {
+	if s.db != nil {
+		err := s.db.Close()
+		if err != nil {
+			return
+		}
+	}
+}
+
+// TestLedgerSuite is the custom test suite to test the ledger journals and postings.
+func TestLedgerSuite(t *testing.T) {
+	suite.Run(t, new(testLedgerSuite))
+}
+
+// expectJournals sets the expectation to post the journals of the given transactions, each debiting the customer
+// and crediting the merchant settlement for a debit transaction, the other way around for a credit transaction.
+func expectJournals(mock sqlmock.Sqlmock, trxs ...TransactionResponse) {
+	journals := make([]journal, 0, len(trxs))
+	for _, trx := range trxs {
+		journals = append(journals, transactionJournal(trx))
+	}
+	expectPostedJournals(mock, journals...)
+}
+
+// expectPostedJournals sets the expectation to post the given journals with their postings.
+func expectPostedJournals(mock sqlmock.Sqlmock, journals ...journal) {
+	var (
+		transactionIDs []int64
+		trxIDs         []int64
+		types          []string
+		accountIDs     []int64
+		directions     []string
+		amounts        []int64
+		currencies     []string
+	)
+	for _, j := range journals {
+		transactionIDs = append(transactionIDs, int64(j.TransactionID))
+		for _, p := range j.Postings {
+			trxIDs = append(trxIDs, int64(j.TransactionID))
+			types = append(types, p.LedgerAccountType)
+			accountIDs = append(accountIDs, int64(p.AccountID))
+			directions = append(directions, p.Direction)
+			amounts = append(amounts, p.Amount.Amount)
+			currencies = append(currencies, p.Amount.Currency)
+		}
+	}
+
+	mock.ExpectExec(ensureLedgerAccountsQuery).
+		WithArgs(pq.Array(types), pq.Array(accountIDs), pq.Array(currencies)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(createJournalsQuery).
+		WithArgs(pq.Array(transactionIDs)).
+		WillReturnResult(sqlmock.NewResult(0, int64(len(transactionIDs))))
+	mock.ExpectExec(createPostingsQuery).
+		WithArgs(pq.Array(trxIDs), pq.Array(types), pq.Array(accountIDs), pq.Array(directions), pq.Array(amounts), pq.Array(currencies)).
+		WillReturnResult(sqlmock.NewResult(0, int64(len(trxIDs))))
+}
+
+// @Success testcase
+func (s *testLedgerSuite) TestTransactionJournal() {
+	t := time.Now()
+	debit := TransactionResponse{TransactionID: 1, AccountID: 7, OperationTypeID: 1, Amount: -5000, Currency: "BRL", EventDate: t}
+	credit := TransactionResponse{TransactionID: 2, AccountID: 7, OperationTypeID: 4, Amount: 5000, Currency: "BRL", EventDate: t}
+
+	s.Equal(journal{
+		TransactionID: 1,
+		Postings: []posting{
+			{LedgerAccountType: LedgerAccountTypeCustomer, AccountID: 7, Direction: PostingDirectionDebit, Amount: Money{Amount: 5000, Currency: "BRL"}},
+			{LedgerAccountType: LedgerAccountTypeMerchantSettlement, Direction: PostingDirectionCredit, Amount: Money{Amount: 5000, Currency: "BRL"}},
+		},
+	}, transactionJournal(debit))
+
+	s.Equal(journal{
+		TransactionID: 2,
+		Postings: []posting{
+			{LedgerAccountType: LedgerAccountTypeCustomer, AccountID: 7, Direction: PostingDirectionCredit, Amount: Money{Amount: 5000, Currency: "BRL"}},
+			{LedgerAccountType: LedgerAccountTypeMerchantSettlement, Direction: PostingDirectionDebit, Amount: Money{Amount: 5000, Currency: "BRL"}},
+		},
+	}, transactionJournal(credit))
+
+	s.NoError(checkJournal(transactionJournal(debit)))
+	s.NoError(checkJournal(transactionJournal(credit)))
+}
+
+// @Success testcase
+func (s *testLedgerSuite) TestTransferJournal() {
+	t := time.Now()
+	debit := TransactionResponse{TransactionID: 20, AccountID: 2, OperationTypeID: 5, Amount: -3000, Currency: "BRL", EventDate: t}
+	credit := TransactionResponse{TransactionID: 21, AccountID: 1, OperationTypeID: 6, Amount: 3000, Currency: "BRL", EventDate: t}
+
+	s.Equal(journal{
+		TransactionID: 20,
+		Postings: []posting{
+			{LedgerAccountType: LedgerAccountTypeCustomer, AccountID: 2, Direction: PostingDirectionDebit, Amount: Money{Amount: 3000, Currency: "BRL"}},
+			{LedgerAccountType: LedgerAccountTypeCustomer, AccountID: 1, Direction: PostingDirectionCredit, Amount: Money{Amount: 3000, Currency: "BRL"}},
+		},
+	}, transferJournal(debit, credit))
+
+	s.NoError(checkJournal(transferJournal(debit, credit)))
+}
+
+// @Success testcase
+func (s *testLedgerSuite) TestCheckJournalBalancedMultiplePostings() {
+	s.NoError(checkJournal(journal{
+		TransactionID: 1,
+		Postings: []posting{
+			{LedgerAccountType: LedgerAccountTypeCustomer, AccountID: 7, Direction: PostingDirectionDebit, Amount: Money{Amount: 5100, Currency: "BRL"}},
+			{LedgerAccountType: LedgerAccountTypeCustomer, AccountID: 8, Direction: PostingDirectionCredit, Amount: Money{Amount: 100, Currency: "BRL"}},
+			{LedgerAccountType: LedgerAccountTypeMerchantSettlement, Direction: PostingDirectionCredit, Amount: Money{Amount: 5000, Currency: "BRL"}},
+		},
+	}))
+}
+
+// @Failed testcase
+func (s *testLedgerSuite) TestCheckJournalUnbalanced() {
+	testcases := map[string][]posting{
+		"no postings": nil,
+		"single posting": {
+			{LedgerAccountType: LedgerAccountTypeCustomer, AccountID: 7, Direction: PostingDirectionDebit, Amount: Money{Amount: 5000, Currency: "BRL"}},
+		},
+		"debits exceed credits": {
+			{LedgerAccountType: LedgerAccountTypeCustomer, AccountID: 7, Direction: PostingDirectionDebit, Amount: Money{Amount: 5000, Currency: "BRL"}},
+			{LedgerAccountType: LedgerAccountTypeMerchantSettlement, Direction: PostingDirectionCredit, Amount: Money{Amount: 4999, Currency: "BRL"}},
+		},
+		"balanced across currencies only": {
+			{LedgerAccountType: LedgerAccountTypeCustomer, AccountID: 7, Direction: PostingDirectionDebit, Amount: Money{Amount: 5000, Currency: "BRL"}},
+			{LedgerAccountType: LedgerAccountTypeMerchantSettlement, Direction: PostingDirectionCredit, Amount: Money{Amount: 5000, Currency: "USD"}},
+		},
+		"zero amount": {
+			{LedgerAccountType: LedgerAccountTypeCustomer, AccountID: 7, Direction: PostingDirectionDebit, Amount: Money{Amount: 0, Currency: "BRL"}},
+			{LedgerAccountType: LedgerAccountTypeMerchantSettlement, Direction: PostingDirectionCredit, Amount: Money{Amount: 0, Currency: "BRL"}},
+		},
+		"unknown direction": {
+			{LedgerAccountType: LedgerAccountTypeCustomer, AccountID: 7, Direction: "transfer", Amount: Money{Amount: 5000, Currency: "BRL"}},
+			{LedgerAccountType: LedgerAccountTypeMerchantSettlement, Direction: PostingDirectionCredit, Amount: Money{Amount: 5000, Currency: "BRL"}},
+		},
+	}
+
+	for name, postings := range testcases {
+		s.ErrorIs(checkJournal(journal{TransactionID: 1, Postings: postings}), ErrUnbalancedJournal, name)
+	}
+}
+
+// @Success testcase
+func (s *testLedgerSuite) TestPostJournals() {
+	t := time.Now()
+	debit := TransactionResponse{TransactionID: 1, AccountID: 7, Amount: -5000, Currency: "BRL", EventDate: t}
+	credit := TransactionResponse{TransactionID: 2, AccountID: 8, Amount: 3000, Currency: "BRL", EventDate: t}
+
+	s.mock.ExpectBegin()
+	expectJournals(s.mock, debit, credit)
+
+	tx, err := s.db.Beginx()
+	s.Require().NoError(err)
+
+	err = postJournals(context.Background(), tx, []journal{transactionJournal(debit), transactionJournal(credit)})
+	s.Require().NoError(err)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testLedgerSuite) TestPostJournalsUnbalanced() {
+	s.mock.ExpectBegin()
+
+	tx, err := s.db.Beginx()
+	s.Require().NoError(err)
+
+	// An unbalanced journal is rejected before anything is written
+	err = postJournals(context.Background(), tx, []journal{{
+		TransactionID: 1,
+		Postings: []posting{
+			{LedgerAccountType: LedgerAccountTypeCustomer, AccountID: 7, Direction: PostingDirectionDebit, Amount: Money{Amount: 5000, Currency: "BRL"}},
+			{LedgerAccountType: LedgerAccountTypeMerchantSettlement, Direction: PostingDirectionCredit, Amount: Money{Amount: 3000, Currency: "BRL"}},
+		},
+	}})
+	s.ErrorIs(err, ErrUnbalancedJournal)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testLedgerSuite) TestPostJournalsError() {
+	trx := TransactionResponse{TransactionID: 1, AccountID: 7, Amount: -5000, Currency: "BRL"}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(ensureLedgerAccountsQuery).
+		WillReturnError(errors.New("something went wrong"))
+
+	tx, err := s.db.Beginx()
+	s.Require().NoError(err)
+
+	err = postJournals(context.Background(), tx, []journal{transactionJournal(trx)})
+	s.Require().Error(err)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency is the ISO-4217 currency of amounts stored before currencies were introduced.
+const DefaultCurrency = "BRL"
+
+var (
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
+	ErrInvalidAmount       = errors.New("invalid amount")
+	ErrAmountScale         = errors.New("amount has more decimal places than the currency allows")
+)
+
+// currencyExponents holds the number of decimal places (minor units) of the supported ISO-4217 currencies.
+var currencyExponents = map[string]int{
+	"ARS": 2,
+	"BRL": 2,
+	"CLP": 0,
+	"COP": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"JPY": 0,
+	"KWD": 3,
+	"MXN": 2,
+	"USD": 2,
+}
+
+// Money is an amount of money in the minor units of its ISO-4217 currency,
+// i.e. Money{Amount: 10050, Currency: "BRL"} is 100.50 BRL.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// moneyJSON is the JSON representation of Money, with the amount as a decimal string
+// so that clients don't have to parse it into a binary float.
+type moneyJSON struct {
+	Value    string `json:"value"`
+	Currency string `json:"currency"`
+}
+
+// moneyJSONInput is the accepted JSON input of Money, with the amount as a decimal string or number.
+type moneyJSONInput struct {
+	Value    json.Number `json:"value"`
+	Currency string      `json:"currency"`
+}
+
+// CurrencyExponent returns the number of decimal places of the given ISO-4217 currency.
+func CurrencyExponent(currency string) (int, error) {
+	exponent, ok := currencyExponents[currency]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrUnsupportedCurrency, currency)
+	}
+
+	return exponent, nil
+}
+
+// SupportedCurrencies returns the supported ISO-4217 currency codes in alphabetical order.
+func SupportedCurrencies() []string {
+	currencies := make([]string, 0, len(currencyExponents))
+	for currency := range currencyExponents {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+
+	return currencies
+}
+
+// ParseMoney parses a decimal amount, e.g. "-100.50", in the given currency.
+// The amount is parsed exactly, and more decimal places than the currency allows are rejected.
+func ParseMoney(amount, currency string) (Money, error) {
+	exponent, err := CurrencyExponent(currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	minorUnits, err := parseMinorUnits(amount, exponent)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{Amount: minorUnits, Currency: currency}, nil
+}
+
+// parseMinorUnits parses a decimal amount into minor units of a currency with the given exponent.
+func parseMinorUnits(amount string, exponent int) (int64, error) {
+	digits := strings.TrimPrefix(amount, "-")
+	negative := len(digits) != len(amount)
+
+	whole, fraction, hasFraction := strings.Cut(digits, ".")
+	if whole == "" || (hasFraction && fraction == "") || !isDigits(whole) || !isDigits(fraction) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, amount)
+	}
+
+	fraction = strings.TrimRight(fraction, "0")
+	if len(fraction) > exponent {
+		return 0, fmt.Errorf("%w: %q allows %d", ErrAmountScale, amount, exponent)
+	}
+
+	minorUnits, err := strconv.ParseInt(whole+fraction+strings.Repeat("0", exponent-len(fraction)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, amount)
+	}
+
+	if negative {
+		minorUnits = -minorUnits
+	}
+
+	return minorUnits, nil
+}
+
+// isDigits reports whether s only holds decimal digits.
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Abs returns the money with a non-negative amount.
+func (m Money) Abs() Money {
+	if m.Amount < 0 {
+		m.Amount = -m.Amount
+	}
+	return m
+}
+
+// Split splits the money into n parts of the same amount, with the remainder of
+// the division added to the first part so that the parts add up to the money.
+func (m Money) Split(n int) []Money {
+	parts := make([]Money, n)
+	for i := range parts {
+		parts[i] = Money{Amount: m.Amount / int64(n), Currency: m.Currency}
+	}
+	parts[0].Amount += m.Amount % int64(n)
+
+	return parts
+}
+
+// String returns the amount as a decimal string in major units, e.g. "-100.50".
+func (m Money) String() string {
+	exponent, ok := currencyExponents[m.Currency]
+	if !ok || exponent == 0 {
+		return strconv.FormatInt(m.Amount, 10)
+	}
+
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		// math.MinInt64 has no positive counterpart, format it from its unsigned value
+		if amount == math.MinInt64 {
+			return sign + formatMinorUnits(strconv.FormatUint(uint64(amount), 10), exponent)
+		}
+		amount = -amount
+	}
+
+	return sign + formatMinorUnits(strconv.FormatInt(amount, 10), exponent)
+}
+
+// formatMinorUnits places the decimal point in the digits of a non-negative amount in minor units.
+func formatMinorUnits(digits string, exponent int) string {
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+	return digits[:len(digits)-exponent] + "." + digits[len(digits)-exponent:]
+}
+
+// MarshalJSON encodes the money as {"value": "100.50", "currency": "BRL"}.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(moneyJSON{
+		Value:    m.String(),
+		Currency: m.Currency,
+	})
+}
+
+// UnmarshalJSON decodes the money from {"value": "100.50", "currency": "BRL"}, where value is
+// a JSON string or number. Values with more decimal places than the currency allows are rejected.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	decoder.UseNumber()
+
+	var raw moneyJSONInput
+	if err := decoder.Decode(&raw); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidAmount, err.Error())
+	}
+
+	money, err := ParseMoney(raw.Value.String(), raw.Currency)
+	if err != nil {
+		return err
+	}
+
+	*m = money
+	return nil
+}
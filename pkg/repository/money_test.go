@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// testMoneySuite is a test suite object to test the Money type.
+type testMoneySuite struct {
+	suite.Suite
+}
+
+// TestMoneySuite is the custom test suite to test the Money type.
+func TestMoneySuite(t *testing.T) {
+	suite.Run(t, new(testMoneySuite))
+}
+
+// @Success testcase
+func (s *testMoneySuite) TestParseMoneySuccess() {
+	testcases := []struct {
+		amount   string
+		currency string
+		expected Money
+	}{
+		{"100.50", "BRL", Money{Amount: 10050, Currency: "BRL"}},
+		{"-100.5", "BRL", Money{Amount: -10050, Currency: "BRL"}},
+		{"100", "USD", Money{Amount: 10000, Currency: "USD"}},
+		{"0.01", "EUR", Money{Amount: 1, Currency: "EUR"}},
+		{"100.500", "BRL", Money{Amount: 10050, Currency: "BRL"}},
+		{"1500", "JPY", Money{Amount: 1500, Currency: "JPY"}},
+		{"1.005", "KWD", Money{Amount: 1005, Currency: "KWD"}},
+	}
+
+	for _, tc := range testcases {
+		actual, err := ParseMoney(tc.amount, tc.currency)
+		s.Require().NoError(err, tc.amount)
+		s.Equal(tc.expected, actual, tc.amount)
+	}
+}
+
+// @Failed testcase
+func (s *testMoneySuite) TestParseMoneyErrors() {
+	testcases := []struct {
+		amount   string
+		currency string
+		expected error
+	}{
+		{"100.005", "BRL", ErrAmountScale},
+		{"10.5", "JPY", ErrAmountScale},
+		{"100", "XXX", ErrUnsupportedCurrency},
+		{"1e2", "BRL", ErrInvalidAmount},
+		{"", "BRL", ErrInvalidAmount},
+		{"10.", "BRL", ErrInvalidAmount},
+		{".5", "BRL", ErrInvalidAmount},
+		{"99999999999999999999", "BRL", ErrInvalidAmount},
+	}
+
+	for _, tc := range testcases {
+		_, err := ParseMoney(tc.amount, tc.currency)
+		s.Require().ErrorIs(err, tc.expected, tc.amount)
+	}
+}
+
+// @Success testcase
+func (s *testMoneySuite) TestMoneyString() {
+	s.Equal("100.50", Money{Amount: 10050, Currency: "BRL"}.String())
+	s.Equal("-0.05", Money{Amount: -5, Currency: "BRL"}.String())
+	s.Equal("1500", Money{Amount: 1500, Currency: "JPY"}.String())
+	s.Equal("1.005", Money{Amount: 1005, Currency: "KWD"}.String())
+	s.Equal("-92233720368547758.08", Money{Amount: math.MinInt64, Currency: "BRL"}.String())
+}
+
+// @Success testcase
+func (s *testMoneySuite) TestMoneySplit() {
+	testcases := []struct {
+		money    Money
+		n        int
+		expected []int64
+	}{
+		{Money{Amount: 10000, Currency: "BRL"}, 4, []int64{2500, 2500, 2500, 2500}},
+		{Money{Amount: 10001, Currency: "BRL"}, 3, []int64{3335, 3333, 3333}},
+		{Money{Amount: -10001, Currency: "BRL"}, 3, []int64{-3335, -3333, -3333}},
+		{Money{Amount: 2, Currency: "BRL"}, 3, []int64{2, 0, 0}},
+		{Money{Amount: 500, Currency: "BRL"}, 1, []int64{500}},
+	}
+
+	for _, tc := range testcases {
+		parts := tc.money.Split(tc.n)
+		s.Require().Len(parts, tc.n)
+
+		var total int64
+		for i, part := range parts {
+			s.Equal(tc.expected[i], part.Amount)
+			s.Equal(tc.money.Currency, part.Currency)
+			total += part.Amount
+		}
+		s.Equal(tc.money.Amount, total)
+	}
+}
+
+// @Success testcase
+func (s *testMoneySuite) TestMoneyJSON() {
+	var money Money
+	s.Require().NoError(json.Unmarshal([]byte(`{"value": 100.50, "currency": "BRL"}`), &money))
+	s.Equal(Money{Amount: 10050, Currency: "BRL"}, money)
+
+	s.Require().NoError(json.Unmarshal([]byte(`{"value": "-7.25", "currency": "USD"}`), &money))
+	s.Equal(Money{Amount: -725, Currency: "USD"}, money)
+
+	data, err := json.Marshal(money)
+	s.Require().NoError(err)
+	s.JSONEq(`{"value": "-7.25", "currency": "USD"}`, string(data))
+}
+
+// @Failed testcase
+func (s *testMoneySuite) TestMoneyJSONErrors() {
+	var money Money
+	s.Require().ErrorIs(json.Unmarshal([]byte(`{"value": 100.005, "currency": "BRL"}`), &money), ErrAmountScale)
+	s.Require().ErrorIs(json.Unmarshal([]byte(`{"value": 100, "currency": "XXX"}`), &money), ErrUnsupportedCurrency)
+	s.Require().ErrorIs(json.Unmarshal([]byte(`{"value": 100, "currency": "BRL", "scale": 2}`), &money), ErrInvalidAmount)
+	s.Require().Error(json.Unmarshal([]byte(`100.50`), &money))
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	listOperationTypesQuery = `SELECT operation_type_id, description, sign, is_active, allows_installments, system_key, created_at, updated_at FROM operations_types ORDER BY operation_type_id;`
+
+	getOperationTypeByIDQuery = `SELECT operation_type_id, description, sign, is_active, allows_installments, system_key, created_at, updated_at FROM operations_types WHERE operation_type_id=$1;`
+
+	createOperationTypeQuery = `
+	INSERT INTO operations_types (description, sign, is_active, allows_installments) VALUES ($1, $2, $3, $4)
+	RETURNING operation_type_id, description, sign, is_active, allows_installments, system_key, created_at, updated_at;
+	`
+
+	lockOperationTypeQuery = `SELECT operation_type_id, description, sign, is_active, allows_installments, system_key, created_at, updated_at FROM operations_types WHERE operation_type_id=$1 FOR UPDATE;`
+
+	updateOperationTypeQuery = `
+	UPDATE operations_types SET
+		description = COALESCE($2, description),
+		sign = COALESCE($3, sign),
+		is_active = COALESCE($4, is_active),
+		allows_installments = COALESCE($5, allows_installments),
+		updated_at = CURRENT_TIMESTAMP
+	WHERE operation_type_id=$1
+	RETURNING operation_type_id, description, sign, is_active, allows_installments, system_key, created_at, updated_at;
+	`
+)
+
+// ListOperationTypes returns all the Operation types ordered by operation type ID.
+func (dr *dataRepo) ListOperationTypes(ctx context.Context) ([]OperationTypeResponse, error) {
+	res := []OperationTypeResponse{}
+	err := dr.db.SelectContext(
+		ctx,
+		&res,
+		listOperationTypesQuery,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetOperationTypeByID returns the Operation type from the database using the provided operation type ID.
+func (dr *dataRepo) GetOperationTypeByID(ctx context.Context, operationTypeID int) (*OperationTypeResponse, error) {
+	var res OperationTypeResponse
+	err := dr.db.GetContext(
+		ctx,
+		&res,
+		getOperationTypeByIDQuery,
+		operationTypeID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// CreateOperationType creates a new Operation type and returns the persisted row.
+func (dr *dataRepo) CreateOperationType(ctx context.Context, req CreateOperationTypeReqParams) (*OperationTypeResponse, error) {
+	var res OperationTypeResponse
+	err := dr.execTxn(ctx, func(tx *sqlx.Tx, audit *auditTrail) error {
+		if err := tx.GetContext(
+			ctx,
+			&res,
+			createOperationTypeQuery,
+			req.Description,
+			req.Sign,
+			req.IsActive,
+			req.AllowsInstallments,
+		); err != nil {
+			return err
+		}
+
+		audit.record(AuditEntityOperationType, res.OperationTypeID, AuditActionCreated, nil, res)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// UpdateOperationType updates the provided fields of an Operation type and returns the persisted row.
+// Nil fields are left unchanged, and sql.ErrNoRows is returned when the operation type doesn't exist.
+// System operation types can't be updated.
+func (dr *dataRepo) UpdateOperationType(ctx context.Context, req UpdateOperationTypeReqParams) (*OperationTypeResponse, error) {
+	var res OperationTypeResponse
+	err := dr.execTxn(ctx, func(tx *sqlx.Tx, audit *auditTrail) error {
+		// Lock the operation type to record it as it was before the update
+		var before OperationTypeResponse
+		if err := tx.GetContext(
+			ctx,
+			&before,
+			lockOperationTypeQuery,
+			req.OperationTypeID,
+		); err != nil {
+			return err
+		}
+
+		// System operation types are posted by the service, which relies on them as they were seeded
+		if before.SystemKey != nil {
+			return ErrSystemOperationType
+		}
+
+		if err := tx.GetContext(
+			ctx,
+			&res,
+			updateOperationTypeQuery,
+			req.OperationTypeID,
+			req.Description,
+			req.Sign,
+			req.IsActive,
+			req.AllowsInstallments,
+		); err != nil {
+			return err
+		}
+
+		audit.record(AuditEntityOperationType, res.OperationTypeID, AuditActionUpdated, before, res)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
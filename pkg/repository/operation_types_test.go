@@ -0,0 +1,274 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/suite"
+)
+
+// testOperationTypesTableSuite is a test suite object to test database operations from Operations types table.
+type testOperationTypesTableSuite struct {
+	suite.Suite
+
+	db   *sqlx.DB
+	mock sqlmock.Sqlmock
+	repo DataRepo
+}
+
+// SetupTest setups and initializes the testOperationTypesTableSuite.
+func (s *testOperationTypesTableSuite) SetupTest() {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	s.Require().NoError(err)
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+
+	s.db = sqlxDB
+	s.mock = mock
+	s.repo = NewDataRepo(sqlxDB)
+}
+
+// TearDownTest gracefully closes the test suite, by closing the db connection.
+func (s *testOperationTypesTableSuite) TearDownTest() {
+	if s.db != nil {
+		err := s.db.Close()
+		if err != nil {
+			return
+		}
+	}
+}
+
+// TestOperationTypesTableSuite is the custom test suite to test database operations from Operations types table.
+func TestOperationTypesTableSuite(t *testing.T) {
+	suite.Run(t, new(testOperationTypesTableSuite))
+}
+
+// operationTypeRows returns the mocked rows for the given Operation type data.
+func operationTypeRows(opType *OperationTypeResponse) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"operation_type_id", "description", "sign", "is_active", "allows_installments", "system_key", "created_at", "updated_at"}).
+		AddRow(
+			opType.OperationTypeID,
+			opType.Description,
+			opType.Sign,
+			opType.IsActive,
+			opType.AllowsInstallments,
+			opType.SystemKey,
+			opType.CreatedAt,
+			opType.UpdatedAt,
+		)
+}
+
+// @Success testcase
+func (s *testOperationTypesTableSuite) TestListOperationTypesSuccess() {
+	t := time.Now()
+	expected := []OperationTypeResponse{
+		{
+			OperationTypeID: 1,
+			Description:     "Normal Purchase",
+			Sign:            -1,
+			IsActive:        true,
+			CreatedAt:       t,
+			UpdatedAt:       t,
+		},
+	}
+
+	s.mock.ExpectQuery(listOperationTypesQuery).
+		WillReturnRows(operationTypeRows(&expected[0]))
+
+	actual, err := s.repo.ListOperationTypes(context.Background())
+	s.Require().NoError(err)
+
+	s.Equal(expected, actual)
+}
+
+// @Failed testcase
+func (s *testOperationTypesTableSuite) TestListOperationTypesError() {
+	s.mock.ExpectQuery(listOperationTypesQuery).
+		WillReturnError(errors.New("something went wrong"))
+
+	actual, err := s.repo.ListOperationTypes(context.Background())
+	s.Require().Error(err)
+
+	s.Require().Nil(actual)
+}
+
+// @Success testcase
+func (s *testOperationTypesTableSuite) TestGetOperationTypeByIDSuccess() {
+	t := time.Now()
+	expected := &OperationTypeResponse{
+		OperationTypeID: 5,
+		Description:     "Refund",
+		Sign:            1,
+		IsActive:        true,
+		CreatedAt:       t,
+		UpdatedAt:       t,
+	}
+
+	s.mock.ExpectQuery(getOperationTypeByIDQuery).
+		WithArgs(expected.OperationTypeID).
+		WillReturnRows(operationTypeRows(expected))
+
+	actual, err := s.repo.GetOperationTypeByID(context.Background(), expected.OperationTypeID)
+	s.Require().NoError(err)
+
+	s.Equal(expected, actual)
+}
+
+// @Failed testcase
+func (s *testOperationTypesTableSuite) TestGetOperationTypeByIDNoRowsError() {
+	s.mock.ExpectQuery(getOperationTypeByIDQuery).
+		WithArgs(5).
+		WillReturnError(sql.ErrNoRows)
+
+	actual, err := s.repo.GetOperationTypeByID(context.Background(), 5)
+	s.Require().ErrorIs(err, sql.ErrNoRows)
+
+	s.Require().Nil(actual)
+}
+
+// @Success testcase
+func (s *testOperationTypesTableSuite) TestCreateOperationTypeSuccess() {
+	req := CreateOperationTypeReqParams{
+		Description: "Refund",
+		Sign:        1,
+		IsActive:    true,
+	}
+	t := time.Now()
+	expected := &OperationTypeResponse{
+		OperationTypeID: 5,
+		Description:     req.Description,
+		Sign:            req.Sign,
+		IsActive:        req.IsActive,
+		CreatedAt:       t,
+		UpdatedAt:       t,
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(createOperationTypeQuery).
+		WithArgs(req.Description, req.Sign, req.IsActive, req.AllowsInstallments).
+		WillReturnRows(operationTypeRows(expected))
+
+	expectAuditEntries(s.mock, []string{AuditEntityOperationType}, []int64{5}, []string{AuditActionCreated})
+
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.CreateOperationType(context.Background(), req)
+	s.Require().NoError(err)
+
+	s.Equal(expected, actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testOperationTypesTableSuite) TestCreateOperationTypeError() {
+	req := CreateOperationTypeReqParams{
+		Description: "Refund",
+		Sign:        1,
+		IsActive:    true,
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(createOperationTypeQuery).
+		WithArgs(req.Description, req.Sign, req.IsActive, req.AllowsInstallments).
+		WillReturnError(errors.New("something went wrong"))
+
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CreateOperationType(context.Background(), req)
+	s.Require().Error(err)
+
+	s.Require().Nil(actual)
+}
+
+// @Success testcase
+func (s *testOperationTypesTableSuite) TestUpdateOperationTypeSuccess() {
+	isActive := false
+	req := UpdateOperationTypeReqParams{
+		OperationTypeID: 5,
+		IsActive:        &isActive,
+	}
+	t := time.Now()
+	expected := &OperationTypeResponse{
+		OperationTypeID: 5,
+		Description:     "Refund",
+		Sign:            1,
+		IsActive:        false,
+		CreatedAt:       t,
+		UpdatedAt:       t,
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(lockOperationTypeQuery).
+		WithArgs(req.OperationTypeID).
+		WillReturnRows(operationTypeRows(&OperationTypeResponse{OperationTypeID: 5, Description: "Refund", Sign: 1, IsActive: true, CreatedAt: t, UpdatedAt: t}))
+
+	s.mock.ExpectQuery(updateOperationTypeQuery).
+		WithArgs(req.OperationTypeID, req.Description, req.Sign, req.IsActive, req.AllowsInstallments).
+		WillReturnRows(operationTypeRows(expected))
+
+	expectAuditEntries(s.mock, []string{AuditEntityOperationType}, []int64{5}, []string{AuditActionUpdated})
+
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.UpdateOperationType(context.Background(), req)
+	s.Require().NoError(err)
+
+	s.Equal(expected, actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testOperationTypesTableSuite) TestUpdateOperationTypeNoRowsError() {
+	description := "Refund"
+	req := UpdateOperationTypeReqParams{
+		OperationTypeID: 5,
+		Description:     &description,
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(lockOperationTypeQuery).
+		WithArgs(req.OperationTypeID).
+		WillReturnError(sql.ErrNoRows)
+
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.UpdateOperationType(context.Background(), req)
+	s.Require().ErrorIs(err, sql.ErrNoRows)
+
+	s.Require().Nil(actual)
+}
+
+// @Failed testcase
+func (s *testOperationTypesTableSuite) TestUpdateOperationTypeSystem() {
+	description := "Transfer"
+	req := UpdateOperationTypeReqParams{
+		OperationTypeID: 6,
+		Description:     &description,
+	}
+	systemKey := TransferInOperationTypeKey
+	t := time.Now()
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(lockOperationTypeQuery).
+		WithArgs(req.OperationTypeID).
+		WillReturnRows(operationTypeRows(&OperationTypeResponse{OperationTypeID: 6, Description: "Transfer In", Sign: 1, IsActive: true, SystemKey: &systemKey, CreatedAt: t, UpdatedAt: t}))
+
+	// The system operation type isn't updated
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.UpdateOperationType(context.Background(), req)
+	s.Require().ErrorIs(err, ErrSystemOperationType)
+
+	s.Require().Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
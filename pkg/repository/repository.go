@@ -3,16 +3,42 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
+// pqUniqueViolation is the postgres error code raised when a unique constraint is violated.
+const pqUniqueViolation = "23505"
+
 // DataRepo is an interface which provides methods for database related operations.
 type DataRepo interface {
-	CreateAccount(ctx context.Context, req CreateAccountReqParams) error
+	CreateAccount(ctx context.Context, req CreateAccountReqParams) (*AccountResponse, error)
 	GetAccountByAccountID(ctx context.Context, accountID int) (*AccountResponse, error)
-	CreateTransaction(ctx context.Context, req CreateTransactionReqParams) error
+	GetAccountByDocumentNumber(ctx context.Context, documentNumber string) (*AccountResponse, error)
+	UpdateAccount(ctx context.Context, req UpdateAccountReqParams) (*AccountResponse, error)
+	UpdateAccountStatus(ctx context.Context, req UpdateAccountStatusReqParams) (*AccountResponse, error)
+	GetAccountBalance(ctx context.Context, accountID int, asOf *time.Time) (*BalanceResponse, error)
+	CreateTransaction(ctx context.Context, req CreateTransactionReqParams) (*TransactionResponse, error)
+	CreateTransactions(ctx context.Context, req CreateTransactionsReqParams) ([]BatchTransactionResult, error)
+	GetTransactionByID(ctx context.Context, transactionID int) (*TransactionDetailsResponse, error)
+	ListTransactions(ctx context.Context, req ListTransactionsReqParams) (*TransactionsPage, error)
+	StreamStatement(ctx context.Context, req StatementReqParams, w StatementWriter) error
+	ReverseTransaction(ctx context.Context, transactionID int) (*ReversalResponse, error)
+	CreateTransfer(ctx context.Context, req CreateTransferReqParams) (*TransferResponse, error)
+	CreateHold(ctx context.Context, req CreateHoldReqParams) (*HoldResponse, error)
+	CaptureHold(ctx context.Context, req CaptureHoldReqParams) (*HoldCaptureResponse, error)
+	VoidHold(ctx context.Context, holdID int) (*HoldResponse, error)
+	ExpireHolds(ctx context.Context, limit int) (int, error)
+	ListInstallments(ctx context.Context, transactionID int) ([]InstallmentResponse, error)
+	ListAuditEntries(ctx context.Context, req ListAuditEntriesReqParams) (*AuditEntriesPage, error)
+	ListOperationTypes(ctx context.Context) ([]OperationTypeResponse, error)
+	GetOperationTypeByID(ctx context.Context, operationTypeID int) (*OperationTypeResponse, error)
+	CreateOperationType(ctx context.Context, req CreateOperationTypeReqParams) (*OperationTypeResponse, error)
+	UpdateOperationType(ctx context.Context, req UpdateOperationTypeReqParams) (*OperationTypeResponse, error)
 }
 
 // dataRepo object.
@@ -27,14 +53,19 @@ func NewDataRepo(db *sqlx.DB) DataRepo {
 	}
 }
 
-// execTxn executes a database transaction for the provided function.
-func (dr *dataRepo) execTxn(ctx context.Context, fn func(*sqlx.Tx) error) error {
+// execTxn executes a database transaction for the provided function. The changes the function records
+// in the audit trail are written to the audit log in the same transaction, so they're committed together.
+func (dr *dataRepo) execTxn(ctx context.Context, fn func(*sqlx.Tx, *auditTrail) error) error {
 	tx, err := dr.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin a transaction: %w", err)
 	}
 
-	err = fn(tx)
+	audit := &auditTrail{}
+	err = fn(tx, audit)
+	if err == nil {
+		err = audit.write(ctx, tx)
+	}
 	if err != nil {
 		if rbErr := tx.Rollback(); rbErr != nil {
 			return fmt.Errorf("failed to rollback the transaction: %w", rbErr)
@@ -47,3 +78,9 @@ func (dr *dataRepo) execTxn(ctx context.Context, fn func(*sqlx.Tx) error) error
 	}
 	return nil
 }
+
+// isUniqueViolation reports whether the error is a violation of the given unique constraint.
+func isUniqueViolation(err error, constraint string) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == pqUniqueViolation && pqErr.Constraint == constraint
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	// transactionsReversesTransactionIDKey is the unique constraint allowing a single reversal per transaction
+	transactionsReversesTransactionIDKey = "transactions_reverses_transaction_id_key"
+
+	getTransactionQuery = `SELECT transaction_id, account_id, operation_type_id, amount, currency, balance, status, reverses_transaction_id, transfer_id, event_date, created_at, updated_at FROM transactions WHERE transaction_id=$1;`
+
+	lockTransactionQuery = `
+	SELECT transaction_id, account_id, operation_type_id, amount, currency, balance, status, reverses_transaction_id, transfer_id, event_date, created_at, updated_at
+	FROM transactions
+	WHERE transaction_id=$1
+	FOR UPDATE;
+	`
+
+	createReversalQuery = `
+	INSERT INTO transactions (account_id, operation_type_id, amount, currency, balance, reverses_transaction_id) VALUES ($1, $2, $3, $4, 0, $5)
+	RETURNING transaction_id, account_id, operation_type_id, amount, currency, balance, status, reverses_transaction_id, transfer_id, event_date, created_at, updated_at;
+	`
+
+	markTransactionReversedQuery = `
+	UPDATE transactions SET status='reversed', balance=0, updated_at=CURRENT_TIMESTAMP
+	WHERE transaction_id=$1
+	RETURNING transaction_id, account_id, operation_type_id, amount, currency, balance, status, reverses_transaction_id, transfer_id, event_date, created_at, updated_at;
+	`
+)
+
+// ReverseTransaction creates a transaction offsetting the given one and marks the original as reversed.
+// Only unsettled transactions can be reversed, and each of them only once.
+// sql.ErrNoRows is returned when the transaction doesn't exist.
+func (dr *dataRepo) ReverseTransaction(ctx context.Context, transactionID int) (*ReversalResponse, error) {
+	var res ReversalResponse
+	err := dr.execTxn(ctx, func(tx *sqlx.Tx, audit *auditTrail) error {
+		// Lock the account before the transaction, in the same order as CreateTransaction
+		var original TransactionResponse
+		if err := tx.GetContext(
+			ctx,
+			&original,
+			getTransactionQuery,
+			transactionID,
+		); err != nil {
+			return err
+		}
+
+		account, err := lockAccount(ctx, tx, original.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.GetContext(
+			ctx,
+			&original,
+			lockTransactionQuery,
+			transactionID,
+		); err != nil {
+			return err
+		}
+
+		if original.ReversesTransactionID != nil {
+			return ErrReversalNotReversible
+		}
+
+		// Reversing one side of a transfer would leave the other side in place
+		if original.TransferID != nil {
+			return ErrTransferNotReversible
+		}
+
+		if original.Status == TransactionStatusReversed {
+			return ErrTransactionAlreadyReversed
+		}
+
+		// Reversing a settled transaction would have to undo its settlements
+		if original.Balance != original.Amount {
+			return ErrTransactionSettled
+		}
+
+		// Reversing a credit uses up the credit limit it restored
+		if original.Amount > 0 && original.Amount > account.AvailableCreditLimit {
+			return ErrInsufficientCreditLimit
+		}
+
+		if err := tx.GetContext(
+			ctx,
+			&res.Reversal,
+			createReversalQuery,
+			original.AccountID,
+			original.OperationTypeID,
+			-original.Amount,
+			original.Currency,
+			original.TransactionID,
+		); err != nil {
+			if isUniqueViolation(err, transactionsReversesTransactionIDKey) {
+				return ErrTransactionAlreadyReversed
+			}
+			return err
+		}
+
+		if err := tx.GetContext(
+			ctx,
+			&res.Original,
+			markTransactionReversedQuery,
+			original.TransactionID,
+		); err != nil {
+			return err
+		}
+
+		audit.record(AuditEntityTransaction, res.Reversal.TransactionID, AuditActionCreated, nil, res.Reversal)
+		audit.record(AuditEntityTransaction, res.Original.TransactionID, AuditActionReversed, original, res.Original)
+
+		// The journal of the reversal offsets the journal of the original transaction
+		if err := postJournals(ctx, tx, []journal{transactionJournal(res.Reversal)}); err != nil {
+			return err
+		}
+
+		// Keep the materialized account balance and credit limit consistent with the transactions
+		_, err = updateAccountBalance(ctx, tx, audit, *account, res.Reversal.Amount)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/suite"
+)
+
+// testReversalsSuite is a test suite object to test the reversal of Transactions.
+type testReversalsSuite struct {
+	suite.Suite
+
+	db   *sqlx.DB
+	mock sqlmock.Sqlmock
+	repo DataRepo
+}
+
+// SetupTest setups and initializes the testReversalsSuite.
+func (s *testReversalsSuite) SetupTest() {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	s.Require().NoError(err)
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+
+	s.db = sqlxDB
+	s.mock = mock
+	s.repo = NewDataRepo(sqlxDB)
+}
+
+// TearDownTest gracefully closes the test suite, by closing the db connection.
+func (s *testReversalsSuite) TearDownTest() {
+	if s.db != nil {
+		err := s.db.Close()
+		if err != nil {
+			return
+		}
+	}
+}
+
+// TestReversalsSuite is the custom test suite to test the reversal of Transactions.
+func TestReversalsSuite(t *testing.T) {
+	suite.Run(t, new(testReversalsSuite))
+}
+
+// postedDebit returns an unsettled debit transaction.
+func postedDebit() *TransactionResponse {
+	t := time.Now()
+	return &TransactionResponse{
+		TransactionID:   1,
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          -5000,
+		Currency:        "BRL",
+		Balance:         -5000,
+		Status:          TransactionStatusPosted,
+		EventDate:       t,
+		CreatedAt:       t,
+		UpdatedAt:       t,
+	}
+}
+
+// expectLockOriginal sets the expectations to read and lock the original transaction and its account.
+func (s *testReversalsSuite) expectLockOriginal(original *TransactionResponse) {
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(getTransactionQuery).
+		WithArgs(original.TransactionID).
+		WillReturnRows(transactionRows(original))
+
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(original.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: original.AccountID, Currency: "BRL", AvailableCreditLimit: 1000}))
+
+	s.mock.ExpectQuery(lockTransactionQuery).
+		WithArgs(original.TransactionID).
+		WillReturnRows(transactionRows(original))
+}
+
+// @Success testcase
+func (s *testReversalsSuite) TestReverseTransactionSuccess() {
+	original := postedDebit()
+	s.expectLockOriginal(original)
+
+	reversal := *original
+	reversal.TransactionID = 2
+	reversal.Amount = 5000
+	reversal.Balance = 0
+	reversal.ReversesTransactionID = &original.TransactionID
+	s.mock.ExpectQuery(createReversalQuery).
+		WithArgs(
+			original.AccountID,
+			original.OperationTypeID,
+			int64(5000),
+			original.Currency,
+			original.TransactionID,
+		).WillReturnRows(transactionRows(&reversal))
+
+	reversed := *original
+	reversed.Balance = 0
+	reversed.Status = TransactionStatusReversed
+	s.mock.ExpectQuery(markTransactionReversedQuery).
+		WithArgs(original.TransactionID).
+		WillReturnRows(transactionRows(&reversed))
+
+	expectJournals(s.mock, reversal)
+
+	s.mock.ExpectQuery(updateAccountBalanceQuery).
+		WithArgs(original.AccountID, int64(5000)).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: original.AccountID}))
+
+	expectAuditEntries(s.mock, []string{AuditEntityTransaction, AuditEntityTransaction, AuditEntityAccount}, []int64{2, 1, 1}, []string{AuditActionCreated, AuditActionReversed, AuditActionUpdated})
+
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.ReverseTransaction(context.Background(), original.TransactionID)
+	s.Require().NoError(err)
+	s.Equal(&ReversalResponse{Reversal: reversal, Original: reversed}, actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testReversalsSuite) TestReverseTransactionNotFound() {
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(getTransactionQuery).
+		WithArgs(1).
+		WillReturnError(sql.ErrNoRows)
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.ReverseTransaction(context.Background(), 1)
+	s.Require().ErrorIs(err, sql.ErrNoRows)
+	s.Require().Nil(actual)
+}
+
+// @Failed testcase
+func (s *testReversalsSuite) TestReverseTransactionAlreadyReversed() {
+	original := postedDebit()
+	original.Balance = 0
+	original.Status = TransactionStatusReversed
+	s.expectLockOriginal(original)
+	s.mock.ExpectRollback()
+
+	_, err := s.repo.ReverseTransaction(context.Background(), original.TransactionID)
+	s.Require().ErrorIs(err, ErrTransactionAlreadyReversed)
+}
+
+// @Failed testcase
+func (s *testReversalsSuite) TestReverseTransactionConcurrentReversal() {
+	original := postedDebit()
+	s.expectLockOriginal(original)
+
+	s.mock.ExpectQuery(createReversalQuery).
+		WithArgs(
+			original.AccountID,
+			original.OperationTypeID,
+			int64(5000),
+			original.Currency,
+			original.TransactionID,
+		).WillReturnError(&pq.Error{Code: pqUniqueViolation, Constraint: transactionsReversesTransactionIDKey})
+	s.mock.ExpectRollback()
+
+	_, err := s.repo.ReverseTransaction(context.Background(), original.TransactionID)
+	s.Require().ErrorIs(err, ErrTransactionAlreadyReversed)
+}
+
+// @Failed testcase
+func (s *testReversalsSuite) TestReverseTransactionSettled() {
+	original := postedDebit()
+	original.Balance = -1000
+	s.expectLockOriginal(original)
+	s.mock.ExpectRollback()
+
+	_, err := s.repo.ReverseTransaction(context.Background(), original.TransactionID)
+	s.Require().ErrorIs(err, ErrTransactionSettled)
+}
+
+// @Failed testcase
+func (s *testReversalsSuite) TestReverseTransactionReversal() {
+	reversesTransactionID := 1
+	original := postedDebit()
+	original.TransactionID = 2
+	original.Amount = 5000
+	original.Balance = 0
+	original.ReversesTransactionID = &reversesTransactionID
+	s.expectLockOriginal(original)
+	s.mock.ExpectRollback()
+
+	_, err := s.repo.ReverseTransaction(context.Background(), original.TransactionID)
+	s.Require().ErrorIs(err, ErrReversalNotReversible)
+}
+
+// @Failed testcase
+func (s *testReversalsSuite) TestReverseTransactionTransfer() {
+	transferID := 7
+	original := postedDebit()
+	original.TransferID = &transferID
+	s.expectLockOriginal(original)
+	s.mock.ExpectRollback()
+
+	_, err := s.repo.ReverseTransaction(context.Background(), original.TransactionID)
+	s.Require().ErrorIs(err, ErrTransferNotReversible)
+}
+
+// @Failed testcase
+func (s *testReversalsSuite) TestReverseCreditInsufficientCreditLimit() {
+	original := postedDebit()
+	original.OperationTypeID = 4
+	original.Amount = 5000
+	original.Balance = 5000
+	s.expectLockOriginal(original)
+	s.mock.ExpectRollback()
+
+	_, err := s.repo.ReverseTransaction(context.Background(), original.TransactionID)
+	s.Require().ErrorIs(err, ErrInsufficientCreditLimit)
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	listOutstandingDebitsQuery = `
+	SELECT transaction_id, balance
+	FROM transactions
+	WHERE account_id=$1 AND balance < 0 AND transaction_id < $2
+	ORDER BY event_date, transaction_id
+	FOR UPDATE;
+	`
+
+	updateTransactionBalanceQuery = `UPDATE transactions SET balance=$2, updated_at=CURRENT_TIMESTAMP WHERE transaction_id=$1;`
+
+	createSettlementQuery = `INSERT INTO transaction_settlements (debit_transaction_id, credit_transaction_id, amount) VALUES ($1, $2, $3);`
+)
+
+// outstandingDebit holds the unpaid balance of a debit transaction.
+type outstandingDebit struct {
+	TransactionID int   `db:"transaction_id"`
+	Balance       int64 `db:"balance"`
+}
+
+// transactionBalance is the balance of a transaction recorded in the audit log when it's settled.
+type transactionBalance struct {
+	TransactionID int   `json:"transaction_id"`
+	Balance       int64 `json:"balance"`
+}
+
+// settleDebits settles the account's outstanding debit transactions oldest first, by event date,
+// with the balance of the given credit transaction. Only debits posted before the credit are
+// settled, so that a batch settles as if its transactions were posted one by one. Each settled
+// amount is recorded, and the credit left after settling stays as the balance of the credit transaction.
+// The balance changes of the settled transactions are recorded in the audit trail.
+func settleDebits(ctx context.Context, tx *sqlx.Tx, audit *auditTrail, credit *TransactionResponse) error {
+	debits := []outstandingDebit{}
+	if err := tx.SelectContext(
+		ctx,
+		&debits,
+		listOutstandingDebitsQuery,
+		credit.AccountID,
+		credit.TransactionID,
+	); err != nil {
+		return err
+	}
+
+	remaining := credit.Balance
+	for _, debit := range debits {
+		if remaining == 0 {
+			break
+		}
+
+		settled := min(remaining, -debit.Balance)
+		if _, err := tx.ExecContext(
+			ctx,
+			updateTransactionBalanceQuery,
+			debit.TransactionID,
+			debit.Balance+settled,
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createSettlementQuery,
+			debit.TransactionID,
+			credit.TransactionID,
+			settled,
+		); err != nil {
+			return err
+		}
+		audit.record(
+			AuditEntityTransaction,
+			debit.TransactionID,
+			AuditActionSettled,
+			transactionBalance{TransactionID: debit.TransactionID, Balance: debit.Balance},
+			transactionBalance{TransactionID: debit.TransactionID, Balance: debit.Balance + settled},
+		)
+
+		remaining -= settled
+	}
+
+	if remaining == credit.Balance {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(
+		ctx,
+		updateTransactionBalanceQuery,
+		credit.TransactionID,
+		remaining,
+	); err != nil {
+		return err
+	}
+
+	audit.record(
+		AuditEntityTransaction,
+		credit.TransactionID,
+		AuditActionSettled,
+		transactionBalance{TransactionID: credit.TransactionID, Balance: credit.Balance},
+		transactionBalance{TransactionID: credit.TransactionID, Balance: remaining},
+	)
+	credit.Balance = remaining
+	return nil
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+const (
+	// The opening balance is the sum of the transactions before the statement period
+	getStatementOpeningQuery = `
+	SELECT a.account_id, a.currency, COALESCE(SUM(t.amount), 0)::BIGINT AS opening_balance
+	FROM accounts a
+	LEFT JOIN transactions t ON t.account_id = a.account_id AND t.event_date < $2
+	WHERE a.account_id=$1
+	GROUP BY a.account_id;
+	`
+
+	listStatementTransactionsQuery = `
+	SELECT transaction_id, account_id, operation_type_id, amount, currency, balance, status, reverses_transaction_id, transfer_id, event_date, created_at, updated_at
+	FROM transactions
+	WHERE account_id=$1
+		AND ($2::TIMESTAMPTZ IS NULL OR event_date >= $2)
+		AND ($3::TIMESTAMPTZ IS NULL OR event_date < $3)
+	ORDER BY event_date, transaction_id;
+	`
+)
+
+// statementOpening holds the Account data and balance at the start of a statement.
+type statementOpening struct {
+	AccountID      int    `db:"account_id"`
+	Currency       string `db:"currency"`
+	OpeningBalance int64  `db:"opening_balance"`
+}
+
+// StreamStatement streams the statement of the account to the StatementWriter, with the transactions
+// of the period ordered by event date. The transactions are read from a database cursor one by one,
+// and the opening balance and transactions are read from the same snapshot so they always add up.
+// sql.ErrNoRows is returned before anything is written when the account doesn't exist.
+func (dr *dataRepo) StreamStatement(ctx context.Context, req StatementReqParams, w StatementWriter) error {
+	tx, err := dr.db.BeginTxx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("failed to begin a transaction: %w", err)
+	}
+	// Nothing is written, so the transaction is always rolled back
+	defer tx.Rollback()
+
+	// Without a start of the period the opening balance is zero
+	var opening statementOpening
+	if err := tx.GetContext(
+		ctx,
+		&opening,
+		getStatementOpeningQuery,
+		req.AccountID,
+		req.From,
+	); err != nil {
+		return err
+	}
+
+	balance := Money{Amount: opening.OpeningBalance, Currency: opening.Currency}
+	if err := w.WriteOpening(balance); err != nil {
+		return err
+	}
+
+	rows, err := tx.QueryxContext(
+		ctx,
+		listStatementTransactionsQuery,
+		req.AccountID,
+		req.From,
+		req.To,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var line StatementLine
+		if err := rows.StructScan(&line.Transaction); err != nil {
+			return err
+		}
+
+		balance.Amount += line.Transaction.Amount
+		line.RunningBalance = balance
+		if err := w.WriteLine(line); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return w.WriteClosing(balance)
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/suite"
+)
+
+// testStatementsSuite is a test suite object to test the account statements.
+type testStatementsSuite struct {
+	suite.Suite
+
+	db   *sqlx.DB
+	mock sqlmock.Sqlmock
+	repo DataRepo
+}
+
+// SetupTest setups and initializes the testStatementsSuite.
+func (s *testStatementsSuite) SetupTest() {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	s.Require().NoError(err)
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+
+	s.db = sqlxDB
+	s.mock = mock
+	s.repo = NewDataRepo(sqlxDB)
+}
+
+// TearDownTest gracefully closes the test suite, by closing the db connection.
+func (s *testStatementsSuite) TearDownTest() {
+	if s.db != nil {
+		err := s.db.Close()
+		if err != nil {
+			return
+		}
+	}
+}
+
+// TestStatementsSuite is the custom test suite to test the account statements.
+func TestStatementsSuite(t *testing.T) {
+	suite.Run(t, new(testStatementsSuite))
+}
+
+// statementRecorder is a StatementWriter recording the statement written to it.
+type statementRecorder struct {
+	opening *Money
+	lines   []StatementLine
+	closing *Money
+}
+
+func (r *statementRecorder) WriteOpening(balance Money) error {
+	r.opening = &balance
+	return nil
+}
+
+func (r *statementRecorder) WriteLine(line StatementLine) error {
+	r.lines = append(r.lines, line)
+	return nil
+}
+
+func (r *statementRecorder) WriteClosing(balance Money) error {
+	r.closing = &balance
+	return nil
+}
+
+// @Success testcase
+func (s *testStatementsSuite) TestStreamStatementSuccess() {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	req := StatementReqParams{AccountID: 1, From: &from}
+
+	t := time.Now()
+	debit := &TransactionResponse{TransactionID: 1, AccountID: 1, OperationTypeID: 1, Amount: -5000, Currency: "BRL", Balance: -5000, Status: TransactionStatusPosted, EventDate: t, CreatedAt: t, UpdatedAt: t}
+	credit := &TransactionResponse{TransactionID: 2, AccountID: 1, OperationTypeID: 4, Amount: 2000, Currency: "BRL", Balance: 0, Status: TransactionStatusPosted, EventDate: t, CreatedAt: t, UpdatedAt: t}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(getStatementOpeningQuery).
+		WithArgs(req.AccountID, req.From).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id", "currency", "opening_balance"}).AddRow(1, "BRL", 10000))
+	s.mock.ExpectQuery(listStatementTransactionsQuery).
+		WithArgs(req.AccountID, req.From, req.To).
+		WillReturnRows(transactionRows(debit).AddRow(
+			credit.TransactionID, credit.AccountID, credit.OperationTypeID, credit.Amount, credit.Currency, credit.Balance,
+			credit.Status, credit.ReversesTransactionID, credit.TransferID, credit.EventDate, credit.CreatedAt, credit.UpdatedAt,
+		))
+	s.mock.ExpectRollback()
+
+	recorder := &statementRecorder{}
+	err := s.repo.StreamStatement(context.Background(), req, recorder)
+	s.Require().NoError(err)
+	s.Equal(&Money{Amount: 10000, Currency: "BRL"}, recorder.opening)
+	s.Equal([]StatementLine{
+		{Transaction: *debit, RunningBalance: Money{Amount: 5000, Currency: "BRL"}},
+		{Transaction: *credit, RunningBalance: Money{Amount: 7000, Currency: "BRL"}},
+	}, recorder.lines)
+	s.Equal(&Money{Amount: 7000, Currency: "BRL"}, recorder.closing)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testStatementsSuite) TestStreamStatementAccountNotFound() {
+	req := StatementReqParams{AccountID: 1}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(getStatementOpeningQuery).
+		WithArgs(req.AccountID, req.From).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id", "currency", "opening_balance"}))
+	s.mock.ExpectRollback()
+
+	recorder := &statementRecorder{}
+	err := s.repo.StreamStatement(context.Background(), req, recorder)
+	s.Require().ErrorIs(err, sql.ErrNoRows)
+	s.Nil(recorder.opening)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testStatementsSuite) TestStreamStatementError() {
+	req := StatementReqParams{AccountID: 1}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(getStatementOpeningQuery).
+		WithArgs(req.AccountID, req.From).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id", "currency", "opening_balance"}).AddRow(1, "BRL", 0))
+	s.mock.ExpectQuery(listStatementTransactionsQuery).
+		WithArgs(req.AccountID, req.From, req.To).
+		WillReturnError(errors.New("something went wrong"))
+	s.mock.ExpectRollback()
+
+	recorder := &statementRecorder{}
+	err := s.repo.StreamStatement(context.Background(), req, recorder)
+	s.Require().Error(err)
+	s.Nil(recorder.closing)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
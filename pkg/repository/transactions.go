@@ -6,19 +6,66 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
+// Transaction statuses.
 const (
-	createTransactionQuery = `INSERT INTO transactions (account_id, operation_type_id, amount) VALUES ($1, $2, $3);`
+	TransactionStatusPosted   = "posted"
+	TransactionStatusReversed = "reversed"
+)
+
+const (
+	createTransactionQuery = `
+	INSERT INTO transactions (account_id, operation_type_id, amount, currency, balance, event_date) VALUES ($1, $2, $3, $4, $3, COALESCE($5, CURRENT_TIMESTAMP))
+	RETURNING transaction_id, account_id, operation_type_id, amount, currency, balance, status, reverses_transaction_id, transfer_id, event_date, created_at, updated_at;
+	`
+
+	getTransactionByIDQuery = `
+	SELECT t.transaction_id, t.account_id, t.operation_type_id, t.amount, t.currency, t.balance, t.status, t.reverses_transaction_id, t.transfer_id,
+		t.event_date, t.created_at, t.updated_at, ot.description AS operation_type_description
+	FROM transactions t
+	JOIN operations_types ot ON ot.operation_type_id = t.operation_type_id
+	WHERE t.transaction_id=$1;
+	`
+
+	listTransactionsQuery = `
+	SELECT transaction_id, account_id, operation_type_id, amount, currency, balance, status, reverses_transaction_id, transfer_id, event_date, created_at, updated_at
+	FROM transactions
+	WHERE account_id=$1
+		AND ($2::INT IS NULL OR operation_type_id=$2)
+		AND ($3::TIMESTAMPTZ IS NULL OR event_date >= $3)
+		AND ($4::TIMESTAMPTZ IS NULL OR event_date < $4)
+		AND transaction_id > $5
+	ORDER BY transaction_id
+	LIMIT $6;
+	`
 
 	validateCreateTrxQuery = `
 	SELECT 
 		EXISTS (SELECT 1 FROM accounts WHERE account_id=$1) AS is_account_exists,
-		EXISTS (SELECT 1 FROM operations_types WHERE operation_type_id=$2) AS is_operation_type_id_exists;
+		EXISTS (SELECT 1 FROM operations_types WHERE operation_type_id=$2) AS is_operation_type_id_exists,
+		COALESCE((SELECT is_active FROM operations_types WHERE operation_type_id=$2), FALSE) AS is_operation_type_active,
+		COALESCE((SELECT sign FROM operations_types WHERE operation_type_id=$2), 0) AS operation_sign,
+		COALESCE((SELECT allows_installments FROM operations_types WHERE operation_type_id=$2), FALSE) AS allows_installments,
+		COALESCE((SELECT system_key IS NOT NULL FROM operations_types WHERE operation_type_id=$2), FALSE) AS is_system_operation_type,
+		COALESCE((SELECT currency FROM accounts WHERE account_id=$1), '') AS account_currency;
 	`
 )
 
-// CreateTransaction creates a new transaction.
-func (dr *dataRepo) CreateTransaction(ctx context.Context, req CreateTransactionReqParams) error {
-	return dr.execTxn(ctx, func(tx *sqlx.Tx) error {
+// CreateTransaction creates a new transaction and returns the persisted row with the stored signed amount.
+func (dr *dataRepo) CreateTransaction(ctx context.Context, req CreateTransactionReqParams) (*TransactionResponse, error) {
+	var res TransactionResponse
+	err := dr.execTxn(ctx, func(tx *sqlx.Tx, audit *auditTrail) error {
+		// Replay the original transaction for a retried request
+		if req.IdempotencyKey != "" {
+			original, err := claimIdempotencyKey(ctx, tx, req.IdempotencyKey, req.RequestHash)
+			if err != nil {
+				return err
+			}
+			if original != nil {
+				res = *original
+				return nil
+			}
+		}
+
 		// Validate if both accountID and operationTypeID exists before creating a transaction
 		var validation validateCreateTrx
 		if err := tx.GetContext(
@@ -39,21 +86,145 @@ func (dr *dataRepo) CreateTransaction(ctx context.Context, req CreateTransaction
 			return ErrOperationTypeIDNotExists
 		}
 
-		// TODO: Get operation_type from operation_type_id and
-		// update the amount (postiive or negative) based on it
+		if !validation.IsOperationTypeActive {
+			return ErrOperationTypeInactive
+		}
+
+		// System operation types are only posted by the service, e.g. the two sides of a transfer
+		if validation.IsSystemOperationType {
+			return ErrSystemOperationType
+		}
+
+		if req.Amount.Currency != validation.AccountCurrency {
+			return ErrCurrencyMismatch
+		}
+
+		if req.Installments > 0 && !validation.AllowsInstallments {
+			return ErrInstallmentsNotAllowed
+		}
+
+		if err := checkInstallments(req.Amount, req.Installments); err != nil {
+			return err
+		}
+
+		// Lock the account so that concurrent debits can't exceed the credit limit together
+		account, err := lockAccount(ctx, tx, req.AccountID)
+		if err != nil {
+			return err
+		}
+
+		// Check the status on the locked row, it may have changed while waiting for the lock
+		if err := checkAccountStatus(account.Status); err != nil {
+			return err
+		}
+
+		amount := applySign(req.Amount, validation.OperationSign)
+		if amount.Amount < 0 && -amount.Amount > account.AvailableCreditLimit {
+			return ErrInsufficientCreditLimit
+		}
 
-		// Create a transaction
-		_, err := tx.ExecContext(
+		// Create a transaction, the amount is always stored with the sign of its operation type
+		if err := tx.GetContext(
 			ctx,
+			&res,
 			createTransactionQuery,
 			req.AccountID,
 			req.OperationTypeID,
-			req.Amount,
-		)
-		if err != nil {
+			amount.Amount,
+			amount.Currency,
+			req.EventDate,
+		); err != nil {
+			return err
+		}
+		audit.record(AuditEntityTransaction, res.TransactionID, AuditActionCreated, nil, res)
+
+		// Record where the money came from and went to in the ledger
+		if err := postJournals(ctx, tx, []journal{transactionJournal(res)}); err != nil {
 			return err
 		}
 
+		if req.Installments > 0 {
+			if err := createInstallments(ctx, tx, &res, req.Installments); err != nil {
+				return err
+			}
+		}
+
+		// A credit pays off the outstanding debits of the account
+		if res.Amount > 0 {
+			if err := settleDebits(ctx, tx, audit, &res); err != nil {
+				return err
+			}
+		}
+
+		// Keep the materialized account balance and credit limit consistent with the transactions
+		if _, err := updateAccountBalance(ctx, tx, audit, *account, res.Amount); err != nil {
+			return err
+		}
+
+		if req.IdempotencyKey != "" {
+			return setIdempotencyKeyResponse(ctx, tx, req.IdempotencyKey, res)
+		}
+
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetTransactionByID returns the Transaction with its operation type description using the provided transaction ID.
+func (dr *dataRepo) GetTransactionByID(ctx context.Context, transactionID int) (*TransactionDetailsResponse, error) {
+	var res TransactionDetailsResponse
+	err := dr.db.GetContext(
+		ctx,
+		&res,
+		getTransactionByIDQuery,
+		transactionID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListTransactions returns a page of the account's transactions ordered by transaction ID.
+// The page starts after the transaction ID given as cursor, and NextCursor is set when more transactions exist.
+func (dr *dataRepo) ListTransactions(ctx context.Context, req ListTransactionsReqParams) (*TransactionsPage, error) {
+	// Fetch one extra row to know if there is a next page
+	transactions := []TransactionResponse{}
+	err := dr.db.SelectContext(
+		ctx,
+		&transactions,
+		listTransactionsQuery,
+		req.AccountID,
+		req.OperationTypeID,
+		req.EventDateFrom,
+		req.EventDateTo,
+		req.Cursor,
+		req.Limit+1,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	page := TransactionsPage{
+		Transactions: transactions,
+	}
+	if len(transactions) > req.Limit {
+		page.Transactions = transactions[:req.Limit]
+		nextCursor := page.Transactions[req.Limit-1].TransactionID
+		page.NextCursor = &nextCursor
+	}
+
+	return &page, nil
+}
+
+// applySign returns the absolute value of the amount with the given sign applied.
+func applySign(amount Money, sign int) Money {
+	amount = amount.Abs()
+	amount.Amount *= int64(sign)
+	return amount
 }
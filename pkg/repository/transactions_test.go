@@ -2,8 +2,11 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/jmoiron/sqlx"
@@ -46,35 +49,347 @@ func TestTransactionsTableSuite(t *testing.T) {
 	suite.Run(t, new(testTransactionsTableSuite))
 }
 
+// transactionRows returns the mocked rows for the given Transaction data.
+func transactionRows(trx *TransactionResponse) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"transaction_id", "account_id", "operation_type_id", "amount", "currency", "balance", "status", "reverses_transaction_id", "transfer_id", "event_date", "created_at", "updated_at"}).
+		AddRow(
+			trx.TransactionID,
+			trx.AccountID,
+			trx.OperationTypeID,
+			trx.Amount,
+			trx.Currency,
+			trx.Balance,
+			trx.Status,
+			trx.ReversesTransactionID,
+			trx.TransferID,
+			trx.EventDate,
+			trx.CreatedAt,
+			trx.UpdatedAt,
+		)
+}
+
+// validateCreateTrxRows returns the mocked rows for the given validation data.
+func validateCreateTrxRows(validation validateCreateTrx) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"is_account_exists", "is_operation_type_id_exists", "is_operation_type_active", "operation_sign", "allows_installments", "is_system_operation_type", "account_currency"}).
+		AddRow(
+			validation.IsAccountExists,
+			validation.IsOperationTypeIDExists,
+			validation.IsOperationTypeActive,
+			validation.OperationSign,
+			validation.AllowsInstallments,
+			validation.IsSystemOperationType,
+			validation.AccountCurrency,
+		)
+}
+
 // @Success testcase
 func (s *testTransactionsTableSuite) TestCreateTransactionSuccess() {
 	req := CreateTransactionReqParams{
 		AccountID:       1,
 		OperationTypeID: 1,
-		Amount:          100.12,
+		Amount:          Money{Amount: 10012, Currency: "BRL"},
+	}
+	t := time.Now()
+	expected := &TransactionResponse{
+		TransactionID:   1,
+		AccountID:       req.AccountID,
+		OperationTypeID: req.OperationTypeID,
+		Amount:          -req.Amount.Amount,
+		Currency:        req.Amount.Currency,
+		Balance:         -req.Amount.Amount,
+		EventDate:       t,
+		CreatedAt:       t,
+		UpdatedAt:       t,
+	}
+
+	s.mock.ExpectBegin()
+
+	validateResponse := validateCreateTrxRows(validateCreateTrx{
+		IsAccountExists:         true,
+		IsOperationTypeIDExists: true,
+		IsOperationTypeActive:   true,
+		OperationSign:           -1,
+		AccountCurrency:         "BRL",
+	})
+	s.mock.ExpectQuery(validateCreateTrxQuery).
+		WithArgs(
+			req.AccountID,
+			req.OperationTypeID,
+		).WillReturnRows(validateResponse)
+
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID, Currency: "BRL", AvailableCreditLimit: 100000}))
+
+	s.mock.ExpectQuery(createTransactionQuery).
+		WithArgs(
+			req.AccountID,
+			req.OperationTypeID,
+			-req.Amount.Amount,
+			req.Amount.Currency,
+			req.EventDate,
+		).WillReturnRows(transactionRows(expected))
+
+	expectJournals(s.mock, *expected)
+
+	s.mock.ExpectQuery(updateAccountBalanceQuery).
+		WithArgs(
+			expected.AccountID,
+			expected.Amount,
+		).WillReturnRows(accountRows(&AccountResponse{AccountID: expected.AccountID}))
+
+	expectAuditEntries(s.mock, []string{AuditEntityTransaction, AuditEntityAccount}, []int64{1, 1}, []string{AuditActionCreated, AuditActionUpdated})
+
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.CreateTransaction(context.Background(), req)
+	s.Require().NoError(err)
+	s.Equal(expected, actual)
+}
+
+// @Success testcase
+func (s *testTransactionsTableSuite) TestCreateTransactionCreditVoucherSign() {
+	req := CreateTransactionReqParams{
+		AccountID:       1,
+		OperationTypeID: 4,
+		Amount:          Money{Amount: -6050, Currency: "BRL"},
+	}
+	t := time.Now()
+	expected := &TransactionResponse{
+		TransactionID:   1,
+		AccountID:       req.AccountID,
+		OperationTypeID: req.OperationTypeID,
+		Amount:          6050,
+		Currency:        "BRL",
+		Balance:         6050,
+		EventDate:       t,
+		CreatedAt:       t,
+		UpdatedAt:       t,
+	}
+
+	s.mock.ExpectBegin()
+
+	validateResponse := validateCreateTrxRows(validateCreateTrx{
+		IsAccountExists:         true,
+		IsOperationTypeIDExists: true,
+		IsOperationTypeActive:   true,
+		OperationSign:           1,
+		AccountCurrency:         "BRL",
+	})
+	s.mock.ExpectQuery(validateCreateTrxQuery).
+		WithArgs(
+			req.AccountID,
+			req.OperationTypeID,
+		).WillReturnRows(validateResponse)
+
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID, Currency: "BRL", AvailableCreditLimit: 100000}))
+
+	s.mock.ExpectQuery(createTransactionQuery).
+		WithArgs(
+			req.AccountID,
+			req.OperationTypeID,
+			int64(6050),
+			"BRL",
+			req.EventDate,
+		).WillReturnRows(transactionRows(expected))
+
+	expectJournals(s.mock, *expected)
+
+	s.mock.ExpectQuery(listOutstandingDebitsQuery).
+		WithArgs(req.AccountID, expected.TransactionID).
+		WillReturnRows(sqlmock.NewRows([]string{"transaction_id", "balance"}))
+
+	s.mock.ExpectQuery(updateAccountBalanceQuery).
+		WithArgs(
+			expected.AccountID,
+			expected.Amount,
+		).WillReturnRows(accountRows(&AccountResponse{AccountID: expected.AccountID}))
+
+	expectAuditEntries(s.mock, []string{AuditEntityTransaction, AuditEntityAccount}, []int64{1, 1}, []string{AuditActionCreated, AuditActionUpdated})
+
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.CreateTransaction(context.Background(), req)
+	s.Require().NoError(err)
+	s.Equal(expected, actual)
+}
+
+// @Success testcase
+func (s *testTransactionsTableSuite) TestCreateTransactionCreditVoucherSettlesDebits() {
+	req := CreateTransactionReqParams{
+		AccountID:       1,
+		OperationTypeID: 4,
+		Amount:          Money{Amount: 6050, Currency: "BRL"},
+	}
+	t := time.Now()
+	created := &TransactionResponse{
+		TransactionID:   3,
+		AccountID:       req.AccountID,
+		OperationTypeID: req.OperationTypeID,
+		Amount:          6050,
+		Currency:        "BRL",
+		Balance:         6050,
+		EventDate:       t,
+		CreatedAt:       t,
+		UpdatedAt:       t,
 	}
 
 	s.mock.ExpectBegin()
 
-	validateResponse := sqlmock.NewRows([]string{"is_account_exists", "is_operation_type_id_exists"}).
-		AddRow(true, true)
+	validateResponse := validateCreateTrxRows(validateCreateTrx{
+		IsAccountExists:         true,
+		IsOperationTypeIDExists: true,
+		IsOperationTypeActive:   true,
+		OperationSign:           1,
+		AccountCurrency:         "BRL",
+	})
 	s.mock.ExpectQuery(validateCreateTrxQuery).
 		WithArgs(
 			req.AccountID,
 			req.OperationTypeID,
 		).WillReturnRows(validateResponse)
 
-	s.mock.ExpectExec(createTransactionQuery).
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID, Currency: "BRL"}))
+
+	s.mock.ExpectQuery(createTransactionQuery).
 		WithArgs(
 			req.AccountID,
 			req.OperationTypeID,
-			req.Amount,
-		).WillReturnResult(sqlmock.NewResult(1, 1))
+			int64(6050),
+			"BRL",
+			req.EventDate,
+		).WillReturnRows(transactionRows(created))
+
+	expectJournals(s.mock, *created)
+
+	// The oldest debit is fully settled and the next one partially
+	s.mock.ExpectQuery(listOutstandingDebitsQuery).
+		WithArgs(req.AccountID, created.TransactionID).
+		WillReturnRows(sqlmock.NewRows([]string{"transaction_id", "balance"}).
+			AddRow(1, -4000).
+			AddRow(2, -5000),
+		)
+
+	s.mock.ExpectExec(updateTransactionBalanceQuery).
+		WithArgs(1, int64(0)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(createSettlementQuery).
+		WithArgs(1, created.TransactionID, int64(4000)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.mock.ExpectExec(updateTransactionBalanceQuery).
+		WithArgs(2, int64(-2950)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(createSettlementQuery).
+		WithArgs(2, created.TransactionID, int64(2050)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.mock.ExpectExec(updateTransactionBalanceQuery).
+		WithArgs(created.TransactionID, int64(0)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.mock.ExpectQuery(updateAccountBalanceQuery).
+		WithArgs(
+			created.AccountID,
+			created.Amount,
+		).WillReturnRows(accountRows(&AccountResponse{AccountID: created.AccountID}))
+
+	expectAuditEntries(s.mock, []string{AuditEntityTransaction, AuditEntityTransaction, AuditEntityTransaction, AuditEntityTransaction, AuditEntityAccount}, []int64{3, 1, 2, 3, 1}, []string{AuditActionCreated, AuditActionSettled, AuditActionSettled, AuditActionSettled, AuditActionUpdated})
 
 	s.mock.ExpectCommit()
 
-	err := s.repo.CreateTransaction(context.Background(), req)
+	actual, err := s.repo.CreateTransaction(context.Background(), req)
 	s.Require().NoError(err)
+	s.Equal(int64(0), actual.Balance)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Success testcase
+func (s *testTransactionsTableSuite) TestCreateTransactionCreditVoucherLeftover() {
+	req := CreateTransactionReqParams{
+		AccountID:       1,
+		OperationTypeID: 4,
+		Amount:          Money{Amount: 6050, Currency: "BRL"},
+	}
+	t := time.Now()
+	created := &TransactionResponse{
+		TransactionID:   2,
+		AccountID:       req.AccountID,
+		OperationTypeID: req.OperationTypeID,
+		Amount:          6050,
+		Currency:        "BRL",
+		Balance:         6050,
+		EventDate:       t,
+		CreatedAt:       t,
+		UpdatedAt:       t,
+	}
+
+	s.mock.ExpectBegin()
+
+	validateResponse := validateCreateTrxRows(validateCreateTrx{
+		IsAccountExists:         true,
+		IsOperationTypeIDExists: true,
+		IsOperationTypeActive:   true,
+		OperationSign:           1,
+		AccountCurrency:         "BRL",
+	})
+	s.mock.ExpectQuery(validateCreateTrxQuery).
+		WithArgs(
+			req.AccountID,
+			req.OperationTypeID,
+		).WillReturnRows(validateResponse)
+
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID, Currency: "BRL"}))
+
+	s.mock.ExpectQuery(createTransactionQuery).
+		WithArgs(
+			req.AccountID,
+			req.OperationTypeID,
+			int64(6050),
+			"BRL",
+			req.EventDate,
+		).WillReturnRows(transactionRows(created))
+
+	expectJournals(s.mock, *created)
+
+	s.mock.ExpectQuery(listOutstandingDebitsQuery).
+		WithArgs(req.AccountID, created.TransactionID).
+		WillReturnRows(sqlmock.NewRows([]string{"transaction_id", "balance"}).
+			AddRow(1, -1000),
+		)
+
+	s.mock.ExpectExec(updateTransactionBalanceQuery).
+		WithArgs(1, int64(0)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(createSettlementQuery).
+		WithArgs(1, created.TransactionID, int64(1000)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// The unused credit stays on the voucher
+	s.mock.ExpectExec(updateTransactionBalanceQuery).
+		WithArgs(created.TransactionID, int64(5050)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.mock.ExpectQuery(updateAccountBalanceQuery).
+		WithArgs(
+			created.AccountID,
+			created.Amount,
+		).WillReturnRows(accountRows(&AccountResponse{AccountID: created.AccountID}))
+
+	expectAuditEntries(s.mock, []string{AuditEntityTransaction, AuditEntityTransaction, AuditEntityTransaction, AuditEntityAccount}, []int64{2, 1, 2, 1}, []string{AuditActionCreated, AuditActionSettled, AuditActionSettled, AuditActionUpdated})
+
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.CreateTransaction(context.Background(), req)
+	s.Require().NoError(err)
+	s.Equal(int64(5050), actual.Balance)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
 }
 
 // @Failed testcase
@@ -82,13 +397,18 @@ func (s *testTransactionsTableSuite) TestCreateTransactionInvalidAccountID() {
 	req := CreateTransactionReqParams{
 		AccountID:       1,
 		OperationTypeID: 1,
-		Amount:          100.12,
+		Amount:          Money{Amount: 10012, Currency: "BRL"},
 	}
 
 	s.mock.ExpectBegin()
 
-	validateResponse := sqlmock.NewRows([]string{"is_account_exists", "is_operation_type_id_exists"}).
-		AddRow(false, true)
+	validateResponse := validateCreateTrxRows(validateCreateTrx{
+		IsAccountExists:         false,
+		IsOperationTypeIDExists: true,
+		IsOperationTypeActive:   true,
+		OperationSign:           -1,
+		AccountCurrency:         "BRL",
+	})
 	s.mock.ExpectQuery(validateCreateTrxQuery).
 		WithArgs(
 			req.AccountID,
@@ -97,23 +417,101 @@ func (s *testTransactionsTableSuite) TestCreateTransactionInvalidAccountID() {
 
 	s.mock.ExpectRollback()
 
-	err := s.repo.CreateTransaction(context.Background(), req)
+	_, err := s.repo.CreateTransaction(context.Background(), req)
 	s.Require().Error(err)
 	s.Require().ErrorIs(err, ErrAccountIDNotExists)
 }
 
+// @Failed testcase
+func (s *testTransactionsTableSuite) TestCreateTransactionBlockedAccount() {
+	req := CreateTransactionReqParams{
+		AccountID:       1,
+		OperationTypeID: 4,
+		Amount:          Money{Amount: 10012, Currency: "BRL"},
+	}
+
+	s.mock.ExpectBegin()
+
+	validateResponse := validateCreateTrxRows(validateCreateTrx{
+		IsAccountExists:         true,
+		IsOperationTypeIDExists: true,
+		IsOperationTypeActive:   true,
+		OperationSign:           1,
+		AccountCurrency:         "BRL",
+	})
+	s.mock.ExpectQuery(validateCreateTrxQuery).
+		WithArgs(
+			req.AccountID,
+			req.OperationTypeID,
+		).WillReturnRows(validateResponse)
+
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID, Currency: "BRL", Status: AccountStatusBlocked}))
+
+	// The transaction isn't created
+	s.mock.ExpectRollback()
+
+	_, err := s.repo.CreateTransaction(context.Background(), req)
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, ErrAccountBlocked)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testTransactionsTableSuite) TestCreateTransactionClosedAccount() {
+	req := CreateTransactionReqParams{
+		AccountID:       1,
+		OperationTypeID: 4,
+		Amount:          Money{Amount: 10012, Currency: "BRL"},
+	}
+
+	s.mock.ExpectBegin()
+
+	validateResponse := validateCreateTrxRows(validateCreateTrx{
+		IsAccountExists:         true,
+		IsOperationTypeIDExists: true,
+		IsOperationTypeActive:   true,
+		OperationSign:           1,
+		AccountCurrency:         "BRL",
+	})
+	s.mock.ExpectQuery(validateCreateTrxQuery).
+		WithArgs(
+			req.AccountID,
+			req.OperationTypeID,
+		).WillReturnRows(validateResponse)
+
+	// The account is closed while the request waits for its lock, after the validation read it
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID, Currency: "BRL", Status: AccountStatusClosed}))
+
+	// The transaction isn't created
+	s.mock.ExpectRollback()
+
+	_, err := s.repo.CreateTransaction(context.Background(), req)
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, ErrAccountClosed)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
 // @Failed testcase
 func (s *testTransactionsTableSuite) TestCreateTransactionInvalidOperationTypeID() {
 	req := CreateTransactionReqParams{
 		AccountID:       1,
 		OperationTypeID: 1,
-		Amount:          100.12,
+		Amount:          Money{Amount: 10012, Currency: "BRL"},
 	}
 
 	s.mock.ExpectBegin()
 
-	validateResponse := sqlmock.NewRows([]string{"is_account_exists", "is_operation_type_id_exists"}).
-		AddRow(true, false)
+	validateResponse := validateCreateTrxRows(validateCreateTrx{
+		IsAccountExists:         true,
+		IsOperationTypeIDExists: false,
+		IsOperationTypeActive:   false,
+		OperationSign:           0,
+		AccountCurrency:         "BRL",
+	})
 	s.mock.ExpectQuery(validateCreateTrxQuery).
 		WithArgs(
 			req.AccountID,
@@ -122,28 +520,427 @@ func (s *testTransactionsTableSuite) TestCreateTransactionInvalidOperationTypeID
 
 	s.mock.ExpectRollback()
 
-	err := s.repo.CreateTransaction(context.Background(), req)
+	_, err := s.repo.CreateTransaction(context.Background(), req)
 	s.Require().Error(err)
 	s.Require().ErrorIs(err, ErrOperationTypeIDNotExists)
 }
 
+// @Failed testcase
+func (s *testTransactionsTableSuite) TestCreateTransactionInactiveOperationType() {
+	req := CreateTransactionReqParams{
+		AccountID:       1,
+		OperationTypeID: 5,
+		Amount:          Money{Amount: 10012, Currency: "BRL"},
+	}
+
+	s.mock.ExpectBegin()
+
+	validateResponse := validateCreateTrxRows(validateCreateTrx{
+		IsAccountExists:         true,
+		IsOperationTypeIDExists: true,
+		IsOperationTypeActive:   false,
+		OperationSign:           -1,
+		AccountCurrency:         "BRL",
+	})
+	s.mock.ExpectQuery(validateCreateTrxQuery).
+		WithArgs(
+			req.AccountID,
+			req.OperationTypeID,
+		).WillReturnRows(validateResponse)
+
+	s.mock.ExpectRollback()
+
+	_, err := s.repo.CreateTransaction(context.Background(), req)
+	s.Require().ErrorIs(err, ErrOperationTypeInactive)
+}
+
+// @Failed testcase
+func (s *testTransactionsTableSuite) TestCreateTransactionSystemOperationType() {
+	req := CreateTransactionReqParams{
+		AccountID:       1,
+		OperationTypeID: 6,
+		Amount:          Money{Amount: 10012, Currency: "BRL"},
+	}
+
+	s.mock.ExpectBegin()
+
+	// A transfer in credit can't be posted without the debit of its transfer
+	validateResponse := validateCreateTrxRows(validateCreateTrx{
+		IsAccountExists:         true,
+		IsOperationTypeIDExists: true,
+		IsOperationTypeActive:   true,
+		OperationSign:           1,
+		IsSystemOperationType:   true,
+		AccountCurrency:         "BRL",
+	})
+	s.mock.ExpectQuery(validateCreateTrxQuery).
+		WithArgs(
+			req.AccountID,
+			req.OperationTypeID,
+		).WillReturnRows(validateResponse)
+
+	s.mock.ExpectRollback()
+
+	_, err := s.repo.CreateTransaction(context.Background(), req)
+	s.Require().ErrorIs(err, ErrSystemOperationType)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testTransactionsTableSuite) TestCreateTransactionCurrencyMismatch() {
+	req := CreateTransactionReqParams{
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          Money{Amount: 10012, Currency: "USD"},
+	}
+
+	s.mock.ExpectBegin()
+
+	validateResponse := validateCreateTrxRows(validateCreateTrx{
+		IsAccountExists:         true,
+		IsOperationTypeIDExists: true,
+		IsOperationTypeActive:   true,
+		OperationSign:           -1,
+		AccountCurrency:         "BRL",
+	})
+	s.mock.ExpectQuery(validateCreateTrxQuery).
+		WithArgs(
+			req.AccountID,
+			req.OperationTypeID,
+		).WillReturnRows(validateResponse)
+
+	s.mock.ExpectRollback()
+
+	_, err := s.repo.CreateTransaction(context.Background(), req)
+	s.Require().ErrorIs(err, ErrCurrencyMismatch)
+}
+
+// @Failed testcase
+func (s *testTransactionsTableSuite) TestCreateTransactionInsufficientCreditLimit() {
+	req := CreateTransactionReqParams{
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          Money{Amount: 10012, Currency: "BRL"},
+	}
+
+	s.mock.ExpectBegin()
+
+	validateResponse := validateCreateTrxRows(validateCreateTrx{
+		IsAccountExists:         true,
+		IsOperationTypeIDExists: true,
+		IsOperationTypeActive:   true,
+		OperationSign:           -1,
+		AccountCurrency:         "BRL",
+	})
+	s.mock.ExpectQuery(validateCreateTrxQuery).
+		WithArgs(
+			req.AccountID,
+			req.OperationTypeID,
+		).WillReturnRows(validateResponse)
+
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID, Currency: "BRL", AvailableCreditLimit: 10011}))
+
+	s.mock.ExpectRollback()
+
+	_, err := s.repo.CreateTransaction(context.Background(), req)
+	s.Require().ErrorIs(err, ErrInsufficientCreditLimit)
+}
+
 // @Failed testcase
 func (s *testTransactionsTableSuite) TestCreateTransactionError() {
 	req := CreateTransactionReqParams{
 		AccountID:       1,
 		OperationTypeID: 1,
-		Amount:          100.12,
+		Amount:          Money{Amount: 10012, Currency: "BRL"},
 	}
 
 	s.mock.ExpectBegin()
-	s.mock.ExpectExec(createTransactionQuery).
+	s.mock.ExpectQuery(validateCreateTrxQuery).
 		WithArgs(
 			req.AccountID,
 			req.OperationTypeID,
-			req.Amount,
 		).WillReturnError(errors.New("something went wrong"))
 	s.mock.ExpectRollback()
 
-	err := s.repo.CreateTransaction(context.Background(), req)
+	_, err := s.repo.CreateTransaction(context.Background(), req)
 	s.Require().Error(err)
 }
+
+// @Success testcase
+func (s *testTransactionsTableSuite) TestGetTransactionByIDSuccess() {
+	t := time.Now()
+	expected := &TransactionDetailsResponse{
+		TransactionResponse: TransactionResponse{
+			TransactionID:   1,
+			AccountID:       1,
+			OperationTypeID: 1,
+			Amount:          -5000,
+			Currency:        "BRL",
+			Balance:         -5000,
+			Status:          TransactionStatusPosted,
+			EventDate:       t,
+			CreatedAt:       t,
+			UpdatedAt:       t,
+		},
+		OperationTypeDescription: "Normal Purchase",
+	}
+
+	rows := sqlmock.NewRows([]string{"transaction_id", "account_id", "operation_type_id", "amount", "currency", "balance", "status", "reverses_transaction_id", "transfer_id", "event_date", "created_at", "updated_at", "operation_type_description"}).
+		AddRow(
+			expected.TransactionID,
+			expected.AccountID,
+			expected.OperationTypeID,
+			expected.Amount,
+			expected.Currency,
+			expected.Balance,
+			expected.Status,
+			expected.ReversesTransactionID,
+			expected.TransferID,
+			expected.EventDate,
+			expected.CreatedAt,
+			expected.UpdatedAt,
+			expected.OperationTypeDescription,
+		)
+	s.mock.ExpectQuery(getTransactionByIDQuery).
+		WithArgs(expected.TransactionID).
+		WillReturnRows(rows)
+
+	actual, err := s.repo.GetTransactionByID(context.Background(), expected.TransactionID)
+	s.Require().NoError(err)
+	s.Equal(expected, actual)
+}
+
+// @Failed testcase
+func (s *testTransactionsTableSuite) TestGetTransactionByIDNoRowsError() {
+	s.mock.ExpectQuery(getTransactionByIDQuery).
+		WithArgs(1).
+		WillReturnError(sql.ErrNoRows)
+
+	actual, err := s.repo.GetTransactionByID(context.Background(), 1)
+	s.Require().ErrorIs(err, sql.ErrNoRows)
+	s.Require().Nil(actual)
+}
+
+// @Success testcase
+func (s *testTransactionsTableSuite) TestListTransactionsNextPage() {
+	operationTypeID := 1
+	req := ListTransactionsReqParams{
+		AccountID:       1,
+		OperationTypeID: &operationTypeID,
+		Cursor:          5,
+		Limit:           1,
+	}
+	t := time.Now()
+	first := &TransactionResponse{
+		TransactionID:   6,
+		AccountID:       req.AccountID,
+		OperationTypeID: operationTypeID,
+		Amount:          -1000,
+		Currency:        "BRL",
+		Balance:         -1000,
+		EventDate:       t,
+		CreatedAt:       t,
+		UpdatedAt:       t,
+	}
+
+	rows := transactionRows(first).
+		AddRow(7, req.AccountID, operationTypeID, -2000, "BRL", -2000, TransactionStatusPosted, nil, nil, t, t, t)
+	s.mock.ExpectQuery(listTransactionsQuery).
+		WithArgs(
+			req.AccountID,
+			req.OperationTypeID,
+			req.EventDateFrom,
+			req.EventDateTo,
+			req.Cursor,
+			req.Limit+1,
+		).WillReturnRows(rows)
+
+	actual, err := s.repo.ListTransactions(context.Background(), req)
+	s.Require().NoError(err)
+
+	s.Equal([]TransactionResponse{*first}, actual.Transactions)
+	s.Require().NotNil(actual.NextCursor)
+	s.Equal(first.TransactionID, *actual.NextCursor)
+}
+
+// @Success testcase
+func (s *testTransactionsTableSuite) TestListTransactionsLastPage() {
+	req := ListTransactionsReqParams{
+		AccountID: 1,
+		Limit:     10,
+	}
+
+	s.mock.ExpectQuery(listTransactionsQuery).
+		WithArgs(
+			req.AccountID,
+			req.OperationTypeID,
+			req.EventDateFrom,
+			req.EventDateTo,
+			req.Cursor,
+			req.Limit+1,
+		).WillReturnRows(sqlmock.NewRows([]string{"transaction_id", "account_id", "operation_type_id", "amount", "currency", "event_date", "created_at", "updated_at"}))
+
+	actual, err := s.repo.ListTransactions(context.Background(), req)
+	s.Require().NoError(err)
+
+	s.Empty(actual.Transactions)
+	s.Nil(actual.NextCursor)
+}
+
+// @Failed testcase
+func (s *testTransactionsTableSuite) TestListTransactionsError() {
+	req := ListTransactionsReqParams{
+		AccountID: 1,
+		Limit:     10,
+	}
+
+	s.mock.ExpectQuery(listTransactionsQuery).
+		WillReturnError(errors.New("something went wrong"))
+
+	actual, err := s.repo.ListTransactions(context.Background(), req)
+	s.Require().Error(err)
+	s.Require().Nil(actual)
+}
+
+// @Success testcase
+func (s *testTransactionsTableSuite) TestCreateTransactionWithIdempotencyKey() {
+	req := CreateTransactionReqParams{
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          Money{Amount: 10012, Currency: "BRL"},
+		IdempotencyKey:  "key-1",
+		RequestHash:     "hash-1",
+	}
+	t := time.Now()
+	expected := &TransactionResponse{
+		TransactionID:   1,
+		AccountID:       req.AccountID,
+		OperationTypeID: req.OperationTypeID,
+		Amount:          -req.Amount.Amount,
+		Currency:        req.Amount.Currency,
+		Balance:         -req.Amount.Amount,
+		EventDate:       t,
+		CreatedAt:       t,
+		UpdatedAt:       t,
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectExec(claimIdempotencyKeyQuery).
+		WithArgs(req.IdempotencyKey, req.RequestHash).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	validateResponse := validateCreateTrxRows(validateCreateTrx{
+		IsAccountExists:         true,
+		IsOperationTypeIDExists: true,
+		IsOperationTypeActive:   true,
+		OperationSign:           -1,
+		AccountCurrency:         "BRL",
+	})
+	s.mock.ExpectQuery(validateCreateTrxQuery).
+		WithArgs(req.AccountID, req.OperationTypeID).
+		WillReturnRows(validateResponse)
+
+	s.mock.ExpectQuery(lockAccountQuery).
+		WithArgs(req.AccountID).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: req.AccountID, Currency: "BRL", AvailableCreditLimit: 100000}))
+
+	s.mock.ExpectQuery(createTransactionQuery).
+		WithArgs(req.AccountID, req.OperationTypeID, -req.Amount.Amount, req.Amount.Currency, req.EventDate).
+		WillReturnRows(transactionRows(expected))
+
+	expectJournals(s.mock, *expected)
+
+	s.mock.ExpectQuery(updateAccountBalanceQuery).
+		WithArgs(expected.AccountID, expected.Amount).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: expected.AccountID}))
+
+	// The transaction is kept as returned, for the retries of the request
+	response, err := json.Marshal(expected)
+	s.Require().NoError(err)
+	s.mock.ExpectExec(setIdempotencyKeyResponseQuery).
+		WithArgs(req.IdempotencyKey, expected.TransactionID, string(response)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	expectAuditEntries(s.mock, []string{AuditEntityTransaction, AuditEntityAccount}, []int64{1, 1}, []string{AuditActionCreated, AuditActionUpdated})
+
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.CreateTransaction(context.Background(), req)
+	s.Require().NoError(err)
+	s.Equal(expected, actual)
+}
+
+// @Success testcase
+func (s *testTransactionsTableSuite) TestCreateTransactionIdempotentReplay() {
+	req := CreateTransactionReqParams{
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          Money{Amount: 10012, Currency: "BRL"},
+		IdempotencyKey:  "key-1",
+		RequestHash:     "hash-1",
+	}
+	// The times are decoded from the replayed response in UTC, without a monotonic clock reading
+	t := time.Now().UTC()
+	expected := &TransactionResponse{
+		TransactionID:   1,
+		AccountID:       req.AccountID,
+		OperationTypeID: req.OperationTypeID,
+		Amount:          -req.Amount.Amount,
+		Currency:        req.Amount.Currency,
+		Balance:         -req.Amount.Amount,
+		EventDate:       t,
+		CreatedAt:       t,
+		UpdatedAt:       t,
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectExec(claimIdempotencyKeyQuery).
+		WithArgs(req.IdempotencyKey, req.RequestHash).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// The original response is replayed, not the transaction as it is now
+	response, err := json.Marshal(expected)
+	s.Require().NoError(err)
+	s.mock.ExpectQuery(getIdempotencyKeyQuery).
+		WithArgs(req.IdempotencyKey).
+		WillReturnRows(sqlmock.NewRows([]string{"request_hash", "response"}).AddRow(req.RequestHash, response))
+
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.CreateTransaction(context.Background(), req)
+	s.Require().NoError(err)
+
+	expected.Replayed = true
+	s.Equal(expected, actual)
+}
+
+// @Failed testcase
+func (s *testTransactionsTableSuite) TestCreateTransactionIdempotencyKeyConflict() {
+	req := CreateTransactionReqParams{
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          Money{Amount: 10012, Currency: "BRL"},
+		IdempotencyKey:  "key-1",
+		RequestHash:     "hash-2",
+	}
+
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectExec(claimIdempotencyKeyQuery).
+		WithArgs(req.IdempotencyKey, req.RequestHash).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	s.mock.ExpectQuery(getIdempotencyKeyQuery).
+		WithArgs(req.IdempotencyKey).
+		WillReturnRows(sqlmock.NewRows([]string{"request_hash", "response"}).AddRow("hash-1", []byte(`{"transaction_id":1}`)))
+
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CreateTransaction(context.Background(), req)
+	s.Require().ErrorIs(err, ErrIdempotencyKeyConflict)
+	s.Require().Nil(actual)
+}
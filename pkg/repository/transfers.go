@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// The system keys of the operation types of the two sides of a transfer, seeded with the transfers table.
+const (
+	TransferOutOperationTypeKey = "transfer_out"
+	TransferInOperationTypeKey  = "transfer_in"
+)
+
+const (
+	getSystemOperationTypeQuery = `SELECT operation_type_id, sign, is_active FROM operations_types WHERE system_key=$1;`
+
+	createTransferQuery = `
+	INSERT INTO transfers (source_account_id, destination_account_id, amount, currency) VALUES ($1, $2, $3, $4)
+	RETURNING transfer_id, source_account_id, destination_account_id, amount, currency, created_at;
+	`
+
+	// The debit on the source account and the credit on the destination account, linked to the transfer
+	createTransferTransactionsQuery = `
+	INSERT INTO transactions (account_id, operation_type_id, amount, currency, balance, transfer_id) VALUES
+		($1, $2, $3, $7, $3, $8),
+		($4, $5, $6, $7, $6, $8)
+	RETURNING transaction_id, account_id, operation_type_id, amount, currency, balance, status, reverses_transaction_id, transfer_id, event_date, created_at, updated_at;
+	`
+)
+
+// CreateTransfer moves the amount from the source account to the destination account, creating a debit
+// on the source and a credit on the destination linked by the transfer. Both accounts must be active and
+// hold the currency of the amount, and the debit must be within the available credit limit of the source.
+// Like any credit, the credit settles the outstanding debits of the destination account.
+func (dr *dataRepo) CreateTransfer(ctx context.Context, req CreateTransferReqParams) (*TransferResponse, error) {
+	if req.SourceAccountID == req.DestinationAccountID {
+		return nil, ErrTransferSameAccount
+	}
+
+	var res TransferResponse
+	err := dr.execTxn(ctx, func(tx *sqlx.Tx, audit *auditTrail) error {
+		// Lock both accounts in account ID order, so that concurrent transfers in opposite directions can't deadlock
+		accounts := []AccountResponse{}
+		if err := tx.SelectContext(
+			ctx,
+			&accounts,
+			lockAccountsQuery,
+			pq.Array([]int64{int64(req.SourceAccountID), int64(req.DestinationAccountID)}),
+		); err != nil {
+			return err
+		}
+
+		accountsByID := make(map[int]*AccountResponse, len(accounts))
+		for i := range accounts {
+			accountsByID[accounts[i].AccountID] = &accounts[i]
+		}
+		source, destination := accountsByID[req.SourceAccountID], accountsByID[req.DestinationAccountID]
+		if source == nil || destination == nil {
+			return ErrAccountIDNotExists
+		}
+
+		for _, account := range []*AccountResponse{source, destination} {
+			if err := checkAccountStatus(account.Status); err != nil {
+				return err
+			}
+			if req.Amount.Currency != account.Currency {
+				return ErrCurrencyMismatch
+			}
+		}
+
+		if req.Amount.Amount > source.AvailableCreditLimit {
+			return ErrInsufficientCreditLimit
+		}
+
+		transferOut, err := getSystemOperationType(ctx, tx, TransferOutOperationTypeKey, -1)
+		if err != nil {
+			return err
+		}
+		transferIn, err := getSystemOperationType(ctx, tx, TransferInOperationTypeKey, 1)
+		if err != nil {
+			return err
+		}
+		debit, credit := applySign(req.Amount, transferOut.Sign), applySign(req.Amount, transferIn.Sign)
+
+		if err := tx.GetContext(
+			ctx,
+			&res,
+			createTransferQuery,
+			req.SourceAccountID,
+			req.DestinationAccountID,
+			req.Amount.Amount,
+			req.Amount.Currency,
+		); err != nil {
+			return err
+		}
+		audit.record(AuditEntityTransfer, res.TransferID, AuditActionCreated, nil, res)
+
+		created := []TransactionResponse{}
+		if err := tx.SelectContext(
+			ctx,
+			&created,
+			createTransferTransactionsQuery,
+			req.SourceAccountID,
+			transferOut.OperationTypeID,
+			debit.Amount,
+			req.DestinationAccountID,
+			transferIn.OperationTypeID,
+			credit.Amount,
+			req.Amount.Currency,
+			res.TransferID,
+		); err != nil {
+			return err
+		}
+		for _, trx := range created {
+			if trx.Amount < 0 {
+				res.Debit = trx
+			} else {
+				res.Credit = trx
+			}
+		}
+		audit.record(AuditEntityTransaction, res.Debit.TransactionID, AuditActionCreated, nil, res.Debit)
+		audit.record(AuditEntityTransaction, res.Credit.TransactionID, AuditActionCreated, nil, res.Credit)
+
+		// Record the money moving from the source customer to the destination customer in the ledger
+		if err := postJournals(ctx, tx, []journal{transferJournal(res.Debit, res.Credit)}); err != nil {
+			return err
+		}
+
+		// The credit pays off the outstanding debits of the destination account
+		if err := settleDebits(ctx, tx, audit, &res.Credit); err != nil {
+			return err
+		}
+
+		// Keep the materialized balances and credit limits consistent, updating the accounts in lock order
+		for _, account := range accounts {
+			change := res.Credit.Amount
+			if account.AccountID == req.SourceAccountID {
+				change = res.Debit.Amount
+			}
+
+			if _, err := updateAccountBalance(ctx, tx, audit, account, change); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// getSystemOperationType returns the system operation type with the given key, which must be active
+// and have the given sign.
+func getSystemOperationType(ctx context.Context, tx *sqlx.Tx, key string, sign int) (*operationTypeSign, error) {
+	var operationType operationTypeSign
+	if err := tx.GetContext(
+		ctx,
+		&operationType,
+		getSystemOperationTypeQuery,
+		key,
+	); err != nil {
+		return nil, err
+	}
+
+	if !operationType.IsActive {
+		return nil, ErrOperationTypeInactive
+	}
+
+	if operationType.Sign != sign {
+		return nil, ErrSystemOperationTypeSign
+	}
+
+	return &operationType, nil
+}
@@ -0,0 +1,281 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/suite"
+)
+
+// testTransfersSuite is a test suite object to test the transfers between accounts.
+type testTransfersSuite struct {
+	suite.Suite
+
+	db   *sqlx.DB
+	mock sqlmock.Sqlmock
+	repo DataRepo
+}
+
+// SetupTest setups and initializes the testTransfersSuite.
+func (s *testTransfersSuite) SetupTest() {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	s.Require().NoError(err)
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+
+	s.db = sqlxDB
+	s.mock = mock
+	s.repo = NewDataRepo(sqlxDB)
+}
+
+// TearDownTest gracefully closes the test suite, by closing the db connection.
+func (s *testTransfersSuite) TearDownTest() {
+	if s.db != nil {
+		err := s.db.Close()
+		if err != nil {
+			return
+		}
+	}
+}
+
+// TestTransfersSuite is the custom test suite to test the transfers between accounts.
+func TestTransfersSuite(t *testing.T) {
+	suite.Run(t, new(testTransfersSuite))
+}
+
+// transferReq returns a transfer from account 2 to account 1, so that the accounts are locked in the reverse order.
+func transferReq() CreateTransferReqParams {
+	return CreateTransferReqParams{
+		SourceAccountID:      2,
+		DestinationAccountID: 1,
+		Amount:               Money{Amount: 3000, Currency: "BRL"},
+	}
+}
+
+// expectLockTransferAccounts sets the expectations to lock the accounts of transferReq, returned in account ID order.
+func (s *testTransfersSuite) expectLockTransferAccounts(destination, source AccountResponse) {
+	s.mock.ExpectBegin()
+
+	s.mock.ExpectQuery(lockAccountsQuery).
+		WithArgs(pq.Array([]int64{2, 1})).
+		WillReturnRows(accountRows(&destination, &source))
+}
+
+// expectTransferOperationTypes sets the expectations to read the operation types of a transfer.
+func (s *testTransfersSuite) expectTransferOperationTypes(isActive bool) {
+	s.mock.ExpectQuery(getSystemOperationTypeQuery).
+		WithArgs(TransferOutOperationTypeKey).
+		WillReturnRows(sqlmock.NewRows([]string{"operation_type_id", "sign", "is_active"}).AddRow(5, -1, isActive))
+	if !isActive {
+		return
+	}
+	s.mock.ExpectQuery(getSystemOperationTypeQuery).
+		WithArgs(TransferInOperationTypeKey).
+		WillReturnRows(sqlmock.NewRows([]string{"operation_type_id", "sign", "is_active"}).AddRow(6, 1, true))
+}
+
+// @Success testcase
+func (s *testTransfersSuite) TestCreateTransferSuccess() {
+	req := transferReq()
+	s.expectLockTransferAccounts(
+		AccountResponse{AccountID: 1, Currency: "BRL", AvailableCreditLimit: 9000, Status: AccountStatusActive},
+		AccountResponse{AccountID: 2, Currency: "BRL", AvailableCreditLimit: 10000, Status: AccountStatusActive},
+	)
+	s.expectTransferOperationTypes(true)
+
+	t := time.Now()
+	transferID := 7
+	expected := &TransferResponse{
+		TransferID:           transferID,
+		SourceAccountID:      2,
+		DestinationAccountID: 1,
+		Amount:               3000,
+		Currency:             "BRL",
+		CreatedAt:            t,
+	}
+	s.mock.ExpectQuery(createTransferQuery).
+		WithArgs(2, 1, int64(3000), "BRL").
+		WillReturnRows(sqlmock.NewRows([]string{"transfer_id", "source_account_id", "destination_account_id", "amount", "currency", "created_at"}).
+			AddRow(expected.TransferID, expected.SourceAccountID, expected.DestinationAccountID, expected.Amount, expected.Currency, expected.CreatedAt))
+
+	debit := &TransactionResponse{TransactionID: 20, AccountID: 2, OperationTypeID: 5, Amount: -3000, Currency: "BRL", Balance: -3000, Status: TransactionStatusPosted, TransferID: &transferID, EventDate: t, CreatedAt: t, UpdatedAt: t}
+	credit := &TransactionResponse{TransactionID: 21, AccountID: 1, OperationTypeID: 6, Amount: 3000, Currency: "BRL", Balance: 3000, Status: TransactionStatusPosted, TransferID: &transferID, EventDate: t, CreatedAt: t, UpdatedAt: t}
+	s.mock.ExpectQuery(createTransferTransactionsQuery).
+		WithArgs(2, 5, int64(-3000), 1, 6, int64(3000), "BRL", transferID).
+		WillReturnRows(transactionRows(debit).AddRow(
+			credit.TransactionID, credit.AccountID, credit.OperationTypeID, credit.Amount, credit.Currency, credit.Balance,
+			credit.Status, credit.ReversesTransactionID, credit.TransferID, credit.EventDate, credit.CreatedAt, credit.UpdatedAt,
+		))
+
+	// One journal moves the money from the source customer to the destination customer
+	expectPostedJournals(s.mock, journal{
+		TransactionID: debit.TransactionID,
+		Postings: []posting{
+			{LedgerAccountType: LedgerAccountTypeCustomer, AccountID: 2, Direction: PostingDirectionDebit, Amount: Money{Amount: 3000, Currency: "BRL"}},
+			{LedgerAccountType: LedgerAccountTypeCustomer, AccountID: 1, Direction: PostingDirectionCredit, Amount: Money{Amount: 3000, Currency: "BRL"}},
+		},
+	})
+
+	// The credit settles the outstanding debit of the destination account
+	s.mock.ExpectQuery(listOutstandingDebitsQuery).
+		WithArgs(credit.AccountID, credit.TransactionID).
+		WillReturnRows(sqlmock.NewRows([]string{"transaction_id", "balance"}).AddRow(3, -1000))
+	s.mock.ExpectExec(updateTransactionBalanceQuery).
+		WithArgs(3, int64(0)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(createSettlementQuery).
+		WithArgs(3, credit.TransactionID, int64(1000)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(updateTransactionBalanceQuery).
+		WithArgs(credit.TransactionID, int64(2000)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// The balances are updated in lock order
+	s.mock.ExpectQuery(updateAccountBalanceQuery).
+		WithArgs(1, int64(3000)).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: 1}))
+	s.mock.ExpectQuery(updateAccountBalanceQuery).
+		WithArgs(2, int64(-3000)).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: 2}))
+
+	expectAuditEntries(s.mock,
+		[]string{AuditEntityTransfer, AuditEntityTransaction, AuditEntityTransaction, AuditEntityTransaction, AuditEntityTransaction, AuditEntityAccount, AuditEntityAccount},
+		[]int64{7, 20, 21, 3, 21, 1, 2},
+		[]string{AuditActionCreated, AuditActionCreated, AuditActionCreated, AuditActionSettled, AuditActionSettled, AuditActionUpdated, AuditActionUpdated},
+	)
+
+	s.mock.ExpectCommit()
+
+	actual, err := s.repo.CreateTransfer(context.Background(), req)
+	s.Require().NoError(err)
+
+	expected.Debit = *debit
+	expected.Credit = *credit
+	expected.Credit.Balance = 2000
+	s.Equal(expected, actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testTransfersSuite) TestCreateTransferSameAccount() {
+	req := transferReq()
+	req.DestinationAccountID = req.SourceAccountID
+
+	actual, err := s.repo.CreateTransfer(context.Background(), req)
+	s.ErrorIs(err, ErrTransferSameAccount)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testTransfersSuite) TestCreateTransferAccountNotExists() {
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(lockAccountsQuery).
+		WithArgs(pq.Array([]int64{2, 1})).
+		WillReturnRows(accountRows(&AccountResponse{AccountID: 2, Currency: "BRL", AvailableCreditLimit: 10000, Status: AccountStatusActive}))
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CreateTransfer(context.Background(), transferReq())
+	s.ErrorIs(err, ErrAccountIDNotExists)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testTransfersSuite) TestCreateTransferDestinationBlocked() {
+	s.expectLockTransferAccounts(
+		AccountResponse{AccountID: 1, Currency: "BRL", AvailableCreditLimit: 9000, Status: AccountStatusBlocked},
+		AccountResponse{AccountID: 2, Currency: "BRL", AvailableCreditLimit: 10000, Status: AccountStatusActive},
+	)
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CreateTransfer(context.Background(), transferReq())
+	s.ErrorIs(err, ErrAccountBlocked)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testTransfersSuite) TestCreateTransferCurrencyMismatch() {
+	s.expectLockTransferAccounts(
+		AccountResponse{AccountID: 1, Currency: "USD", AvailableCreditLimit: 9000, Status: AccountStatusActive},
+		AccountResponse{AccountID: 2, Currency: "BRL", AvailableCreditLimit: 10000, Status: AccountStatusActive},
+	)
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CreateTransfer(context.Background(), transferReq())
+	s.ErrorIs(err, ErrCurrencyMismatch)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testTransfersSuite) TestCreateTransferInsufficientCreditLimit() {
+	s.expectLockTransferAccounts(
+		AccountResponse{AccountID: 1, Currency: "BRL", AvailableCreditLimit: 9000, Status: AccountStatusActive},
+		AccountResponse{AccountID: 2, Currency: "BRL", AvailableCreditLimit: 2999, Status: AccountStatusActive},
+	)
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CreateTransfer(context.Background(), transferReq())
+	s.ErrorIs(err, ErrInsufficientCreditLimit)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testTransfersSuite) TestCreateTransferOperationTypeInactive() {
+	s.expectLockTransferAccounts(
+		AccountResponse{AccountID: 1, Currency: "BRL", AvailableCreditLimit: 9000, Status: AccountStatusActive},
+		AccountResponse{AccountID: 2, Currency: "BRL", AvailableCreditLimit: 10000, Status: AccountStatusActive},
+	)
+	s.expectTransferOperationTypes(false)
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CreateTransfer(context.Background(), transferReq())
+	s.ErrorIs(err, ErrOperationTypeInactive)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testTransfersSuite) TestCreateTransferOperationTypeSign() {
+	s.expectLockTransferAccounts(
+		AccountResponse{AccountID: 1, Currency: "BRL", AvailableCreditLimit: 9000, Status: AccountStatusActive},
+		AccountResponse{AccountID: 2, Currency: "BRL", AvailableCreditLimit: 10000, Status: AccountStatusActive},
+	)
+
+	// The transfer out operation type must debit the source account
+	s.mock.ExpectQuery(getSystemOperationTypeQuery).
+		WithArgs(TransferOutOperationTypeKey).
+		WillReturnRows(sqlmock.NewRows([]string{"operation_type_id", "sign", "is_active"}).AddRow(5, 1, true))
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CreateTransfer(context.Background(), transferReq())
+	s.ErrorIs(err, ErrSystemOperationTypeSign)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
+
+// @Failed testcase
+func (s *testTransfersSuite) TestCreateTransferError() {
+	s.expectLockTransferAccounts(
+		AccountResponse{AccountID: 1, Currency: "BRL", AvailableCreditLimit: 9000, Status: AccountStatusActive},
+		AccountResponse{AccountID: 2, Currency: "BRL", AvailableCreditLimit: 10000, Status: AccountStatusActive},
+	)
+	s.expectTransferOperationTypes(true)
+	s.mock.ExpectQuery(createTransferQuery).
+		WithArgs(2, 1, int64(3000), "BRL").
+		WillReturnError(errors.New("something went wrong"))
+	s.mock.ExpectRollback()
+
+	actual, err := s.repo.CreateTransfer(context.Background(), transferReq())
+	s.Require().Error(err)
+	s.Nil(actual)
+	s.Require().NoError(s.mock.ExpectationsWereMet())
+}
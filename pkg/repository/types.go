@@ -6,31 +6,364 @@ import (
 )
 
 var (
-	ErrAccountIDNotExists       = errors.New("account id not exists")
-	ErrOperationTypeIDNotExists = errors.New("operation type id not exists")
+	ErrDocumentNumberAlreadyExists = errors.New("document number already exists")
+	ErrAccountIDNotExists          = errors.New("account id not exists")
+	ErrAccountBlocked              = errors.New("account is blocked")
+	ErrAccountClosed               = errors.New("account is closed")
+	ErrAccountStatusTransition     = errors.New("account status can't be changed to the requested status")
+	ErrOperationTypeIDNotExists    = errors.New("operation type id not exists")
+	ErrOperationTypeInactive       = errors.New("operation type is inactive")
+	ErrCurrencyMismatch            = errors.New("transaction currency doesn't match the account currency")
+	ErrInsufficientCreditLimit     = errors.New("transaction amount exceeds the available credit limit")
+	ErrCreditLimitInUse            = errors.New("credit limit is lower than the credit in use")
+	ErrInstallmentsNotAllowed      = errors.New("installments are not allowed for the operation type")
+	ErrInstallmentsExceedAmount    = errors.New("installments exceed the amount in minor units")
+	ErrTransactionAlreadyReversed  = errors.New("transaction already reversed")
+	ErrTransactionSettled          = errors.New("transaction is already settled and can't be reversed")
+	ErrReversalNotReversible       = errors.New("a reversal transaction can't be reversed")
+	ErrIdempotencyKeyConflict      = errors.New("idempotency key already used with a different request")
+	ErrBatchRejected               = errors.New("batch rejected as some of its transactions can't be created")
+	ErrUnbalancedJournal           = errors.New("journal debits and credits don't balance")
+	ErrTransferSameAccount         = errors.New("transfer source and destination accounts must be different")
+	ErrTransferNotReversible       = errors.New("a transfer transaction can't be reversed on its own")
+	ErrSystemOperationType         = errors.New("operation type is reserved for the system")
+	ErrSystemOperationTypeSign     = errors.New("system operation type doesn't have the expected sign")
+	ErrHoldNotDebit                = errors.New("holds are only allowed for debit operation types")
+	ErrHoldInstallmentsNotAllowed  = errors.New("holds are not allowed for operation types with installments")
+	ErrHoldNotActive               = errors.New("hold is not active")
+	ErrHoldExpired                 = errors.New("hold is expired")
+	ErrCaptureExceedsHold          = errors.New("capture amount exceeds the held amount")
 )
 
 // CreateAccountReqParams is the request object for CreateAccount method.
+// CreditLimit is in minor units of the Currency, and is all available on creation.
 type CreateAccountReqParams struct {
 	DocumentNumber string `db:"document_number"`
+	Currency       string `db:"currency"`
+	CreditLimit    int64  `db:"credit_limit"`
+}
+
+// UpdateAccountReqParams is the request object for UpdateAccount method.
+// Nil fields are left unchanged.
+type UpdateAccountReqParams struct {
+	AccountID   int
+	CreditLimit *Money
+}
+
+// UpdateAccountStatusReqParams is the request object for UpdateAccountStatus method.
+// Reason is recorded in the status history of the account.
+type UpdateAccountStatusReqParams struct {
+	AccountID int
+	Status    string
+	Reason    string
 }
 
 // AccountResponse is the response object which holds Account data.
+// Balance, CreditLimit and AvailableCreditLimit are in minor units of the Currency.
 type AccountResponse struct {
-	AccountID      int       `db:"account_id"`
-	DocumentNumber string    `db:"document_number"`
-	CreatedAt      time.Time `db:"created_at"`
-	UpdatedAt      time.Time `db:"updated_at"`
+	AccountID            int       `db:"account_id" json:"account_id"`
+	DocumentNumber       string    `db:"document_number" json:"document_number"`
+	Currency             string    `db:"currency" json:"currency"`
+	Balance              int64     `db:"balance" json:"balance"`
+	CreditLimit          int64     `db:"credit_limit" json:"credit_limit"`
+	AvailableCreditLimit int64     `db:"available_credit_limit" json:"available_credit_limit"`
+	Status               string    `db:"status" json:"status"`
+	CreatedAt            time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt            time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// CreditLimitMoney returns the credit limit of the account.
+func (a *AccountResponse) CreditLimitMoney() Money {
+	return Money{Amount: a.CreditLimit, Currency: a.Currency}
+}
+
+// AvailableCreditLimitMoney returns the available credit limit of the account.
+func (a *AccountResponse) AvailableCreditLimitMoney() Money {
+	return Money{Amount: a.AvailableCreditLimit, Currency: a.Currency}
+}
+
+// BalanceResponse is the response object which holds the balance of an Account.
+// Balance is in minor units of the account's currency.
+type BalanceResponse struct {
+	AccountID int       `db:"account_id"`
+	Balance   int64     `db:"balance"`
+	Currency  string    `db:"currency"`
+	AsOf      time.Time `db:"as_of"`
 }
 
 // CreateTransactionReqParams is the request object for CreateTransaction method.
+// Installments is the number of installments of a purchase with installments, none are created when zero.
+// EventDate defaults to the current time when nil.
+// When IdempotencyKey is set, RequestHash identifies the request the key was used with.
 type CreateTransactionReqParams struct {
 	AccountID       int
 	OperationTypeID int
-	Amount          float64
+	Amount          Money
+	Installments    int
+	EventDate       *time.Time
+	IdempotencyKey  string
+	RequestHash     string
+}
+
+// CreateTransactionsReqParams is the request object for CreateTransactions method.
+// When Atomic is set, no transaction of the batch is created unless all of them can be.
+type CreateTransactionsReqParams struct {
+	Transactions []CreateTransactionReqParams
+	Atomic       bool
+}
+
+// BatchTransactionResult is the result of a transaction of a batch, either the created Transaction or the error it failed with.
+type BatchTransactionResult struct {
+	Transaction *TransactionResponse
+	Err         error
+}
+
+// TransactionResponse is the response object which holds Transaction data.
+// Amount is the signed amount in minor units of the Currency, and Balance is the part of it not settled yet.
+// ReversesTransactionID is set on a reversal to the transaction it reverses.
+type TransactionResponse struct {
+	TransactionID         int       `db:"transaction_id" json:"transaction_id"`
+	AccountID             int       `db:"account_id" json:"account_id"`
+	OperationTypeID       int       `db:"operation_type_id" json:"operation_type_id"`
+	Amount                int64     `db:"amount" json:"amount"`
+	Currency              string    `db:"currency" json:"currency"`
+	Balance               int64     `db:"balance" json:"balance"`
+	Status                string    `db:"status" json:"status"`
+	ReversesTransactionID *int      `db:"reverses_transaction_id" json:"reverses_transaction_id"`
+	TransferID            *int      `db:"transfer_id" json:"transfer_id"`
+	EventDate             time.Time `db:"event_date" json:"event_date"`
+	CreatedAt             time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt             time.Time `db:"updated_at" json:"updated_at"`
+
+	// Replayed is set when the transaction was created by an earlier request with the same idempotency key.
+	Replayed bool `db:"-" json:"-"`
+}
+
+// Money returns the signed amount of the transaction.
+func (t *TransactionResponse) Money() Money {
+	return Money{Amount: t.Amount, Currency: t.Currency}
+}
+
+// UnsettledMoney returns the signed balance of the transaction not settled yet.
+func (t *TransactionResponse) UnsettledMoney() Money {
+	return Money{Amount: t.Balance, Currency: t.Currency}
+}
+
+// InstallmentResponse is the response object which holds Installment data.
+// Amount is the signed amount in minor units of the Currency.
+type InstallmentResponse struct {
+	TransactionID int       `db:"transaction_id"`
+	Number        int       `db:"number"`
+	DueDate       time.Time `db:"due_date"`
+	Amount        int64     `db:"amount"`
+	Currency      string    `db:"currency"`
+	Status        string    `db:"-"`
+}
+
+// Money returns the signed amount of the installment.
+func (i *InstallmentResponse) Money() Money {
+	return Money{Amount: i.Amount, Currency: i.Currency}
+}
+
+// TransactionDetailsResponse is the response object which holds Transaction data with its operation type description.
+type TransactionDetailsResponse struct {
+	TransactionResponse
+	OperationTypeDescription string `db:"operation_type_description"`
+}
+
+// ReversalResponse is the response object which holds a reversal and the transaction it reversed.
+type ReversalResponse struct {
+	Reversal TransactionResponse
+	Original TransactionResponse
+}
+
+// CreateTransferReqParams is the request object for CreateTransfer method.
+// Amount is the positive amount moved from the source account to the destination account.
+type CreateTransferReqParams struct {
+	SourceAccountID      int
+	DestinationAccountID int
+	Amount               Money
+}
+
+// TransferResponse is the response object which holds a transfer with its debit on the source account
+// and credit on the destination account. The transactions are audited on their own.
+type TransferResponse struct {
+	TransferID           int       `db:"transfer_id" json:"transfer_id"`
+	SourceAccountID      int       `db:"source_account_id" json:"source_account_id"`
+	DestinationAccountID int       `db:"destination_account_id" json:"destination_account_id"`
+	Amount               int64     `db:"amount" json:"amount"`
+	Currency             string    `db:"currency" json:"currency"`
+	CreatedAt            time.Time `db:"created_at" json:"created_at"`
+
+	Debit  TransactionResponse `db:"-" json:"-"`
+	Credit TransactionResponse `db:"-" json:"-"`
+}
+
+// Money returns the amount of the transfer.
+func (t *TransferResponse) Money() Money {
+	return Money{Amount: t.Amount, Currency: t.Currency}
+}
+
+// CreateHoldReqParams is the request object for CreateHold method.
+// Amount is the positive amount held, and the hold expires TTL after it's created.
+type CreateHoldReqParams struct {
+	AccountID       int
+	OperationTypeID int
+	Amount          Money
+	TTL             time.Duration
+}
+
+// CaptureHoldReqParams is the request object for CaptureHold method.
+// The whole held amount is captured when Amount is nil.
+type CaptureHoldReqParams struct {
+	HoldID int
+	Amount *Money
+}
+
+// HoldResponse is the response object which holds Hold data.
+// Amount is the positive held amount in minor units of the Currency, and CapturedAmount the part of it
+// captured into the transaction with TransactionID.
+type HoldResponse struct {
+	HoldID          int       `db:"hold_id" json:"hold_id"`
+	AccountID       int       `db:"account_id" json:"account_id"`
+	OperationTypeID int       `db:"operation_type_id" json:"operation_type_id"`
+	Amount          int64     `db:"amount" json:"amount"`
+	CapturedAmount  int64     `db:"captured_amount" json:"captured_amount"`
+	Currency        string    `db:"currency" json:"currency"`
+	Status          string    `db:"status" json:"status"`
+	TransactionID   *int      `db:"transaction_id" json:"transaction_id"`
+	ExpiresAt       time.Time `db:"expires_at" json:"expires_at"`
+	CreatedAt       time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt       time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// Money returns the held amount.
+func (h *HoldResponse) Money() Money {
+	return Money{Amount: h.Amount, Currency: h.Currency}
+}
+
+// CapturedMoney returns the captured amount.
+func (h *HoldResponse) CapturedMoney() Money {
+	return Money{Amount: h.CapturedAmount, Currency: h.Currency}
+}
+
+// lockedHold holds the Hold data read while holding a row lock, and whether it's past its expiry.
+type lockedHold struct {
+	HoldResponse
+	IsExpired bool `db:"is_expired"`
+}
+
+// HoldCaptureResponse is the response object which holds a captured hold and the transaction it was captured into.
+type HoldCaptureResponse struct {
+	Hold        HoldResponse
+	Transaction TransactionResponse
+}
+
+// ListTransactionsReqParams is the request object for ListTransactions method.
+// Nil filters are not applied and Cursor is the last transaction ID of the previous page.
+type ListTransactionsReqParams struct {
+	AccountID       int
+	OperationTypeID *int
+	EventDateFrom   *time.Time
+	EventDateTo     *time.Time
+	Cursor          int
+	Limit           int
+}
+
+// TransactionsPage is the response object which holds a page of Transactions.
+type TransactionsPage struct {
+	Transactions []TransactionResponse
+	NextCursor   *int
+}
+
+// StatementReqParams is the request object for StreamStatement method.
+// The period is the event date range from From, inclusive, to To, exclusive. Nil bounds are not applied.
+type StatementReqParams struct {
+	AccountID int
+	From      *time.Time
+	To        *time.Time
+}
+
+// StatementLine is a Transaction of an account statement, with the balance of the account after it.
+type StatementLine struct {
+	Transaction    TransactionResponse
+	RunningBalance Money
+}
+
+// StatementWriter receives an account statement as it's streamed from the database.
+// WriteOpening is called first with the balance before the period, then WriteLine for each
+// transaction of the period, and WriteClosing last with the balance at the end of the period.
+type StatementWriter interface {
+	WriteOpening(balance Money) error
+	WriteLine(line StatementLine) error
+	WriteClosing(balance Money) error
 }
 
 type validateCreateTrx struct {
-	IsAccountExists         bool `db:"is_account_exists"`
-	IsOperationTypeIDExists bool `db:"is_operation_type_id_exists"`
+	IsAccountExists         bool   `db:"is_account_exists"`
+	IsOperationTypeIDExists bool   `db:"is_operation_type_id_exists"`
+	IsOperationTypeActive   bool   `db:"is_operation_type_active"`
+	OperationSign           int    `db:"operation_sign"`
+	AllowsInstallments      bool   `db:"allows_installments"`
+	IsSystemOperationType   bool   `db:"is_system_operation_type"`
+	AccountCurrency         string `db:"account_currency"`
+}
+
+// OperationTypeResponse is the response object which holds Operation type data.
+type OperationTypeResponse struct {
+	OperationTypeID    int       `db:"operation_type_id" json:"operation_type_id"`
+	Description        string    `db:"description" json:"description"`
+	Sign               int       `db:"sign" json:"sign"`
+	IsActive           bool      `db:"is_active" json:"is_active"`
+	AllowsInstallments bool      `db:"allows_installments" json:"allows_installments"`
+	SystemKey          *string   `db:"system_key" json:"system_key,omitempty"`
+	CreatedAt          time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt          time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// CreateOperationTypeReqParams is the request object for CreateOperationType method.
+type CreateOperationTypeReqParams struct {
+	Description        string
+	Sign               int
+	IsActive           bool
+	AllowsInstallments bool
+}
+
+// UpdateOperationTypeReqParams is the request object for UpdateOperationType method.
+// Nil fields are left unchanged.
+type UpdateOperationTypeReqParams struct {
+	OperationTypeID    int
+	Description        *string
+	Sign               *int
+	IsActive           *bool
+	AllowsInstallments *bool
+}
+
+// ListAuditEntriesReqParams is the request object for ListAuditEntries method.
+// Cursor is the last audit ID of the previous page.
+type ListAuditEntriesReqParams struct {
+	Entity   string
+	EntityID int
+	Cursor   int64
+	Limit    int
+}
+
+// AuditEntryResponse is the response object which holds an audit log entry.
+// Before and After are the JSON encoded entity, Before is nil for a created entity.
+type AuditEntryResponse struct {
+	AuditID   int64     `db:"audit_id"`
+	Entity    string    `db:"entity"`
+	EntityID  int       `db:"entity_id"`
+	Action    string    `db:"action"`
+	Actor     string    `db:"actor"`
+	RequestID string    `db:"request_id"`
+	Before    []byte    `db:"before"`
+	After     []byte    `db:"after"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// AuditEntriesPage is the response object which holds a page of audit log entries.
+type AuditEntriesPage struct {
+	Entries    []AuditEntryResponse
+	NextCursor *int64
 }
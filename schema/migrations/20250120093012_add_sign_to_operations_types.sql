@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- sign holds the direction applied to a transaction amount of the operation type:
+-- -1 for debits (purchases, withdrawals) and 1 for credits (vouchers).
+ALTER TABLE operations_types ADD COLUMN sign SMALLINT NOT NULL DEFAULT 1 CHECK (sign IN (-1, 1));
+
+UPDATE operations_types SET sign = -1 WHERE description IN 
+    ('Normal Purchase', 'Purchase With Installments', 'Withdrawal');
+UPDATE operations_types SET sign = 1 WHERE description = 'Credit Voucher';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE operations_types DROP COLUMN IF EXISTS sign;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Supports keyset pagination of an account's transactions on transaction_id
+CREATE INDEX IF NOT EXISTS transactions_account_id_transaction_id_idx ON transactions (account_id, transaction_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS transactions_account_id_transaction_id_idx;
+-- +goose StatementEnd
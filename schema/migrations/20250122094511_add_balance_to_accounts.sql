@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- balance is the running sum of the account's signed transaction amounts,
+-- kept up to date in the same db transaction that creates a transaction.
+ALTER TABLE accounts ADD COLUMN balance DECIMAL(15, 2) NOT NULL DEFAULT 0;
+
+UPDATE accounts a SET balance = COALESCE(
+    (SELECT SUM(t.amount) FROM transactions t WHERE t.account_id = a.account_id), 0
+);
+
+-- Supports point-in-time balances of an account
+CREATE INDEX IF NOT EXISTS transactions_account_id_event_date_idx ON transactions (account_id, event_date);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS transactions_account_id_event_date_idx;
+ALTER TABLE accounts DROP COLUMN IF EXISTS balance;
+-- +goose StatementEnd
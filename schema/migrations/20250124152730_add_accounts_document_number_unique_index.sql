@@ -0,0 +1,46 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- +goose StatementBegin
+-- The unique index can't be built while accounts share a document number. Fail with the duplicates to merge
+-- or remove first, rather than with the bare unique violation of the index build.
+DO $$
+DECLARE
+    duplicates TEXT;
+BEGIN
+    SELECT string_agg(document_number || ' (' || accounts || ' accounts)', ', ' ORDER BY document_number)
+    INTO duplicates
+    FROM (
+        SELECT document_number, COUNT(*) AS accounts
+        FROM accounts
+        GROUP BY document_number
+        HAVING COUNT(*) > 1
+        LIMIT 20
+    ) d;
+
+    IF duplicates IS NOT NULL THEN
+        RAISE EXCEPTION 'accounts share document numbers: %', duplicates
+            USING HINT = 'Merge or remove the duplicate accounts so that each document number has one account, then run the migrations again.';
+    END IF;
+
+    -- A concurrent build that failed leaves an invalid index behind, which IF NOT EXISTS would keep
+    IF EXISTS (
+        SELECT 1 FROM pg_index i
+        JOIN pg_class c ON c.oid = i.indexrelid
+        WHERE c.relname = 'accounts_document_number_key' AND NOT i.indisvalid
+    ) THEN
+        RAISE EXCEPTION 'index accounts_document_number_key is invalid'
+            USING HINT = 'Run DROP INDEX CONCURRENTLY accounts_document_number_key, then run the migrations again.';
+    END IF;
+END;
+$$;
+-- +goose StatementEnd
+
+-- Built concurrently, so that the accounts stay writable while the index is built on a large table
+-- +goose StatementBegin
+CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS accounts_document_number_key ON accounts (document_number);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX CONCURRENTLY IF EXISTS accounts_document_number_key;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Inactive operation types are kept for existing transactions but can't be used for new ones.
+ALTER TABLE operations_types ADD COLUMN is_active BOOLEAN NOT NULL DEFAULT TRUE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE operations_types DROP COLUMN IF EXISTS is_active;
+-- +goose StatementEnd
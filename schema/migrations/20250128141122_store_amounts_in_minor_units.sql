@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Amounts are stored as integer minor units of their ISO-4217 currency, i.e. 100.50 BRL is 10050.
+ALTER TABLE transactions ALTER COLUMN amount TYPE BIGINT USING ROUND(amount * 100)::BIGINT;
+ALTER TABLE transactions ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'BRL';
+
+ALTER TABLE accounts ALTER COLUMN balance TYPE BIGINT USING ROUND(balance * 100)::BIGINT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE accounts ALTER COLUMN balance TYPE DECIMAL(15, 2) USING balance / 100.0;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS currency;
+ALTER TABLE transactions ALTER COLUMN amount TYPE DECIMAL(15, 2) USING amount / 100.0;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- currency is the ISO-4217 currency of the account, transactions must be in the same currency.
+ALTER TABLE accounts ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'BRL';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE accounts DROP COLUMN IF EXISTS currency;
+-- +goose StatementEnd
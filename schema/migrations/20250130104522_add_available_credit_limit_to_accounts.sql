@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- credit_limit is the limit set on the account and available_credit_limit what's left of it, both in minor units
+-- of the account currency. Debit transactions can't exceed the available credit limit. Debits use it up, credits
+-- restore it, and a change of the credit limit moves it by the same amount.
+ALTER TABLE accounts ADD COLUMN credit_limit BIGINT CHECK (credit_limit >= 0);
+ALTER TABLE accounts ADD COLUMN available_credit_limit BIGINT CHECK (available_credit_limit >= 0);
+
+-- Existing accounts were created without a limit and accounts of any currency may exist, so no single amount
+-- of minor units is a safe default. They get a limit of 0, i.e. no credit, until their limit is set through
+-- PATCH /app/v1/accounts/{id}.
+UPDATE accounts SET credit_limit = 0, available_credit_limit = 0;
+
+ALTER TABLE accounts ALTER COLUMN credit_limit SET NOT NULL;
+ALTER TABLE accounts ALTER COLUMN available_credit_limit SET NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE accounts DROP COLUMN IF EXISTS available_credit_limit;
+ALTER TABLE accounts DROP COLUMN IF EXISTS credit_limit;
+-- +goose StatementEnd
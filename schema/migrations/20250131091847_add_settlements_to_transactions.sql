@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- balance is the part of the amount not settled yet, in minor units of the transaction currency.
+-- Debits are settled by credit vouchers oldest first, and the unused credit stays on the voucher.
+ALTER TABLE transactions ADD COLUMN balance BIGINT NOT NULL DEFAULT 0;
+UPDATE transactions SET balance = amount;
+
+CREATE INDEX transactions_outstanding_debits_idx ON transactions (account_id, event_date) WHERE balance < 0;
+
+CREATE TABLE transaction_settlements (
+    settlement_id SERIAL PRIMARY KEY,
+    debit_transaction_id INT NOT NULL,
+    credit_transaction_id INT NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (debit_transaction_id) REFERENCES transactions(transaction_id),
+    FOREIGN KEY (credit_transaction_id) REFERENCES transactions(transaction_id)
+);
+
+CREATE INDEX transaction_settlements_debit_transaction_id_idx ON transaction_settlements (debit_transaction_id);
+CREATE INDEX transaction_settlements_credit_transaction_id_idx ON transaction_settlements (credit_transaction_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS transaction_settlements;
+DROP INDEX IF EXISTS transactions_outstanding_debits_idx;
+ALTER TABLE transactions DROP COLUMN IF EXISTS balance;
+-- +goose StatementEnd
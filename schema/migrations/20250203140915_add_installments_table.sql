@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- installments holds the payment schedule of a purchase with installments, amounts are signed
+-- like the parent transaction and in minor units of its currency.
+CREATE TABLE installments (
+    installment_id SERIAL PRIMARY KEY,
+    transaction_id INT NOT NULL,
+    number SMALLINT NOT NULL CHECK (number > 0),
+    due_date DATE NOT NULL,
+    amount BIGINT NOT NULL,
+    currency CHAR(3) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (transaction_id) REFERENCES transactions(transaction_id),
+    UNIQUE (transaction_id, number)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS installments;
+-- +goose StatementEnd
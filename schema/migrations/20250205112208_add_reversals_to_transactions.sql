@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A reversal is an offsetting transaction linked to the transaction it reverses,
+-- the unique constraint makes sure a transaction is reversed at most once.
+ALTER TABLE transactions ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'posted' CHECK (status IN ('posted', 'reversed'));
+ALTER TABLE transactions ADD COLUMN reverses_transaction_id INT REFERENCES transactions(transaction_id);
+ALTER TABLE transactions ADD CONSTRAINT transactions_reverses_transaction_id_key UNIQUE (reverses_transaction_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_reverses_transaction_id_key;
+ALTER TABLE transactions DROP COLUMN IF EXISTS reverses_transaction_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS status;
+-- +goose StatementEnd
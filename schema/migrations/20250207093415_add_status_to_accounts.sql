@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Transactions can only be posted to active accounts, blocked accounts can be reactivated and closed ones can't.
+-- account_status_history records every status change of an account with the reason it was made for.
+ALTER TABLE accounts ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'blocked', 'closed'));
+
+CREATE TABLE account_status_history (
+    account_status_history_id SERIAL PRIMARY KEY,
+    account_id INT NOT NULL,
+    from_status VARCHAR(16) NOT NULL,
+    to_status VARCHAR(16) NOT NULL,
+    reason VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (account_id) REFERENCES accounts(account_id)
+);
+
+CREATE INDEX account_status_history_account_id_idx ON account_status_history (account_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS account_status_history;
+ALTER TABLE accounts DROP COLUMN IF EXISTS status;
+-- +goose StatementEnd
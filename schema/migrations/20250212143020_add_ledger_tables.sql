@@ -0,0 +1,93 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The double-entry ledger behind the transactions. Each transaction has a journal of postings, each posting
+-- a debit or credit of a positive amount against a ledger account, and the debits and credits of a journal
+-- balance in each currency. Customer ledger accounts belong to an account, the merchant settlement one is system wide.
+CREATE TABLE ledger_accounts (
+    ledger_account_id SERIAL PRIMARY KEY,
+    type VARCHAR(32) NOT NULL CHECK (type IN ('customer', 'merchant_settlement')),
+    account_id INT,
+    currency CHAR(3) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (account_id) REFERENCES accounts(account_id),
+    CHECK ((type = 'customer') = (account_id IS NOT NULL))
+);
+
+CREATE UNIQUE INDEX ledger_accounts_customer_key ON ledger_accounts (account_id, currency) WHERE account_id IS NOT NULL;
+CREATE UNIQUE INDEX ledger_accounts_system_key ON ledger_accounts (type, currency) WHERE account_id IS NULL;
+
+CREATE TABLE journals (
+    journal_id SERIAL PRIMARY KEY,
+    transaction_id INT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (transaction_id) REFERENCES transactions(transaction_id),
+    UNIQUE (transaction_id)
+);
+
+CREATE TABLE postings (
+    posting_id SERIAL PRIMARY KEY,
+    journal_id INT NOT NULL,
+    ledger_account_id INT NOT NULL,
+    direction VARCHAR(6) NOT NULL CHECK (direction IN ('debit', 'credit')),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency CHAR(3) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (journal_id) REFERENCES journals(journal_id),
+    FOREIGN KEY (ledger_account_id) REFERENCES ledger_accounts(ledger_account_id)
+);
+
+CREATE INDEX postings_journal_id_idx ON postings (journal_id);
+CREATE INDEX postings_ledger_account_id_idx ON postings (ledger_account_id);
+
+-- Post the existing transactions, debiting the customer for a debit and crediting it for a credit
+INSERT INTO ledger_accounts (type, account_id, currency)
+SELECT DISTINCT 'customer', account_id, currency FROM transactions;
+
+INSERT INTO ledger_accounts (type, currency)
+SELECT DISTINCT 'merchant_settlement', currency FROM transactions;
+
+INSERT INTO journals (transaction_id, created_at)
+SELECT transaction_id, created_at FROM transactions WHERE amount <> 0;
+
+INSERT INTO postings (journal_id, ledger_account_id, direction, amount, currency)
+SELECT j.journal_id, la.ledger_account_id, CASE WHEN t.amount < 0 THEN 'debit' ELSE 'credit' END, ABS(t.amount), t.currency
+FROM transactions t
+JOIN journals j ON j.transaction_id = t.transaction_id
+JOIN ledger_accounts la ON la.type = 'customer' AND la.account_id = t.account_id AND la.currency = t.currency
+UNION ALL
+SELECT j.journal_id, la.ledger_account_id, CASE WHEN t.amount < 0 THEN 'credit' ELSE 'debit' END, ABS(t.amount), t.currency
+FROM transactions t
+JOIN journals j ON j.transaction_id = t.transaction_id
+JOIN ledger_accounts la ON la.type = 'merchant_settlement' AND la.account_id IS NULL AND la.currency = t.currency;
+
+-- An unbalanced journal is rejected when its transaction commits, after all its postings are written
+CREATE FUNCTION postings_check_journal_balanced() RETURNS TRIGGER AS $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM postings
+        WHERE journal_id = NEW.journal_id
+        GROUP BY currency
+        HAVING SUM(CASE WHEN direction = 'debit' THEN amount ELSE -amount END) <> 0
+    ) THEN
+        RAISE EXCEPTION 'journal % is unbalanced', NEW.journal_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER postings_check_journal_balanced
+    AFTER INSERT ON postings
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION postings_check_journal_balanced();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS postings;
+DROP FUNCTION IF EXISTS postings_check_journal_balanced();
+DROP TABLE IF EXISTS journals;
+DROP TABLE IF EXISTS ledger_accounts;
+-- +goose StatementEnd
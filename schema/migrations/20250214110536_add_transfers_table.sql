@@ -0,0 +1,76 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A transfer moves money between two accounts with a debit on the source and a credit on the destination,
+-- both transactions linked to the transfer.
+CREATE TABLE transfers (
+    transfer_id SERIAL PRIMARY KEY,
+    source_account_id INT NOT NULL,
+    destination_account_id INT NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency CHAR(3) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (source_account_id) REFERENCES accounts(account_id),
+    FOREIGN KEY (destination_account_id) REFERENCES accounts(account_id),
+    CHECK (source_account_id <> destination_account_id)
+);
+
+ALTER TABLE transactions ADD COLUMN transfer_id INT REFERENCES transfers(transfer_id);
+CREATE INDEX transactions_transfer_id_idx ON transactions (transfer_id);
+
+-- System operation types are only posted by the service itself. They're found by their key,
+-- as the description of an operation type isn't unique and can be edited.
+ALTER TABLE operations_types ADD COLUMN system_key VARCHAR(32) UNIQUE;
+
+INSERT INTO operations_types (description, sign, system_key) VALUES
+    ('Transfer Out', -1, 'transfer_out'),
+    ('Transfer In', 1, 'transfer_in');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- The transactions of transfers are deleted with the system operation types. The amounts they settled
+-- are given back to the other side of the settlements and their amounts are taken out of the accounts.
+CREATE TEMPORARY TABLE transfer_transactions ON COMMIT DROP AS
+SELECT transaction_id, account_id, amount FROM transactions WHERE transfer_id IS NOT NULL;
+
+UPDATE transactions t SET balance = t.balance - s.amount
+FROM (
+    SELECT debit_transaction_id AS transaction_id, SUM(amount) AS amount
+    FROM transaction_settlements
+    WHERE credit_transaction_id IN (SELECT transaction_id FROM transfer_transactions)
+    GROUP BY debit_transaction_id
+) s
+WHERE t.transaction_id = s.transaction_id;
+
+UPDATE transactions t SET balance = t.balance + s.amount
+FROM (
+    SELECT credit_transaction_id AS transaction_id, SUM(amount) AS amount
+    FROM transaction_settlements
+    WHERE debit_transaction_id IN (SELECT transaction_id FROM transfer_transactions)
+    GROUP BY credit_transaction_id
+) s
+WHERE t.transaction_id = s.transaction_id;
+
+DELETE FROM transaction_settlements
+WHERE debit_transaction_id IN (SELECT transaction_id FROM transfer_transactions)
+    OR credit_transaction_id IN (SELECT transaction_id FROM transfer_transactions);
+
+DELETE FROM postings
+WHERE journal_id IN (SELECT journal_id FROM journals WHERE transaction_id IN (SELECT transaction_id FROM transfer_transactions));
+DELETE FROM journals WHERE transaction_id IN (SELECT transaction_id FROM transfer_transactions);
+
+UPDATE accounts a SET
+    balance = a.balance - t.amount,
+    available_credit_limit = GREATEST(a.available_credit_limit - t.amount, 0)
+FROM (SELECT account_id, SUM(amount) AS amount FROM transfer_transactions GROUP BY account_id) t
+WHERE a.account_id = t.account_id;
+
+DELETE FROM transactions WHERE transaction_id IN (SELECT transaction_id FROM transfer_transactions);
+
+DROP INDEX IF EXISTS transactions_transfer_id_idx;
+ALTER TABLE transactions DROP COLUMN IF EXISTS transfer_id;
+DROP TABLE IF EXISTS transfers;
+DELETE FROM operations_types WHERE system_key IN ('transfer_out', 'transfer_in');
+ALTER TABLE operations_types DROP COLUMN IF EXISTS system_key;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A hold reserves the available credit of an account for a debit authorized but not booked yet.
+-- It's captured into a transaction of at most its amount, voided, or expired once it's past expires_at.
+CREATE TABLE holds (
+    hold_id SERIAL PRIMARY KEY,
+    account_id INT NOT NULL,
+    operation_type_id INT NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    captured_amount BIGINT NOT NULL DEFAULT 0 CHECK (captured_amount >= 0 AND captured_amount <= amount),
+    currency CHAR(3) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'captured', 'voided', 'expired')),
+    transaction_id INT,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (account_id) REFERENCES accounts(account_id),
+    FOREIGN KEY (operation_type_id) REFERENCES operations_types(operation_type_id),
+    FOREIGN KEY (transaction_id) REFERENCES transactions(transaction_id)
+);
+
+CREATE INDEX holds_account_id_idx ON holds (account_id);
+CREATE INDEX holds_active_expires_at_idx ON holds (expires_at) WHERE status = 'active';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Release the credit reserved by the active holds
+UPDATE accounts a SET available_credit_limit = a.available_credit_limit + h.amount
+FROM (SELECT account_id, SUM(amount) AS amount FROM holds WHERE status = 'active' GROUP BY account_id) h
+WHERE a.account_id = h.account_id;
+
+DROP TABLE IF EXISTS holds;
+-- +goose StatementEnd
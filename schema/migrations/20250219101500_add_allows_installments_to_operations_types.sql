@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Only transactions of operation types allowing installments can be paid in installments.
+ALTER TABLE operations_types ADD COLUMN allows_installments BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Purchases with installments were the only operation type allowing them until now. Operation types can be
+-- created since, so it's found by its seeded description rather than its ID, along with any operation type
+-- that already has transactions paid in installments.
+UPDATE operations_types SET allows_installments = TRUE
+WHERE description = 'Purchase With Installments'
+    OR operation_type_id IN (
+        SELECT t.operation_type_id FROM transactions t JOIN installments i ON i.transaction_id = t.transaction_id
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE operations_types DROP COLUMN IF EXISTS allows_installments;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The transaction as it was returned to the request that used the key, replayed to a retry of the request
+-- instead of the transaction as it is by then, settled or reversed since.
+ALTER TABLE idempotency_keys ADD COLUMN response JSONB;
+
+-- The responses of the keys used so far weren't kept, the closest is the transaction as it is now
+UPDATE idempotency_keys k SET response = to_jsonb(t)
+FROM (
+    SELECT transaction_id, account_id, operation_type_id, amount, currency, balance, status,
+        reverses_transaction_id, transfer_id, event_date, created_at, updated_at
+    FROM transactions
+) t
+WHERE t.transaction_id = k.transaction_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS response;
+-- +goose StatementEnd